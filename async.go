@@ -0,0 +1,274 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// OverflowPolicy defines what an async SimpleLogger does when its queue is full
+type OverflowPolicy int
+
+// All OverflowPolicy(s) which SimpleLogger supports
+const (
+	// OverflowPolicyBlock blocks logging until there is space in the queue
+	OverflowPolicyBlock OverflowPolicy = iota
+	// OverflowPolicyDropNewest drops the entry which should be queued
+	OverflowPolicyDropNewest
+	// OverflowPolicyDropOldest drops the oldest queued entry to make space for the new one
+	OverflowPolicyDropOldest
+)
+
+// SetAsync makes the SimpleLogger hand entries to a background goroutine which writes them to the output.
+// size is the number of entries which can be queued before logging blocks.
+// Call Close before exiting to make sure all queued entries are written.
+// The queue of a previous SetAsync call is drained first while the output stays open
+func (l *SimpleLogger) SetAsync(size int) {
+	defer l.debugSetting("SetAsync", size)
+	l.mu.Lock()
+	queue := l.async
+	l.async = nil
+	l.mu.Unlock()
+	if queue != nil {
+		_, _ = queue.close(context.Background())
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.async = newAsyncQueue(l.output, size)
+}
+
+// SetOverflowPolicy sets what an async SimpleLogger does when its queue is full. Defaults to OverflowPolicyBlock.
+// Dropped entries are counted and can be retrieved with Dropped
+func (l *SimpleLogger) SetOverflowPolicy(policy OverflowPolicy) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.overflowPolicy = policy
+}
+
+// Dropped returns the number of entries dropped because the queue of the async SimpleLogger was full or a write timed out
+func (l *SimpleLogger) Dropped() uint64 {
+	return atomic.LoadUint64(&l.dropped)
+}
+
+// Suppressed returns the number of entries skipped because their Level was not enabled.
+// Entries skipped by the inlined comparison of LogIfEnabled are not counted
+func (l *SimpleLogger) Suppressed() uint64 {
+	return atomic.LoadUint64(&l.suppressed)
+}
+
+// ResetCounters resets the counters returned by Dropped and Suppressed
+func (l *SimpleLogger) ResetCounters() {
+	atomic.StoreUint64(&l.dropped, 0)
+	atomic.StoreUint64(&l.suppressed, 0)
+}
+
+// QueueLen returns the number of queued entries of an async SimpleLogger or 0 if the SimpleLogger is not async
+func (l *SimpleLogger) QueueLen() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.async == nil {
+		return 0
+	}
+	return len(l.async.entries)
+}
+
+// QueueCap returns the queue size of an async SimpleLogger or 0 if the SimpleLogger is not async
+func (l *SimpleLogger) QueueCap() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.async == nil {
+		return 0
+	}
+	return cap(l.async.entries)
+}
+
+// Close writes all queued and buffered entries and stops the background goroutine of an async SimpleLogger.
+// A file opened by Configure is closed as well
+func (l *SimpleLogger) Close() error {
+	return l.CloseContext(context.Background())
+}
+
+// CloseContext is like Close but stops waiting for queued entries to be written once ctx is done.
+// The remaining queued entries are dropped and counted by Dropped. The buffer is still flushed and the output closed
+// unless a write is still in progress after ctx is done and doesn't finish within the write timeout, see SetWriteTimeout.
+// The returned error wraps ctx.Err() and reports the number of dropped entries
+func (l *SimpleLogger) CloseContext(ctx context.Context) error {
+	l.mu.Lock()
+	queue := l.async
+	l.async = nil
+	l.mu.Unlock()
+
+	var queueErr error
+	if queue != nil {
+		if dropped, err := queue.close(ctx); err != nil {
+			queueErr = fmt.Errorf("%d queued entries dropped: %w", dropped, err)
+		}
+	}
+
+	if ctx.Err() == nil {
+		l.lockOutput()
+	} else if !l.tryLockOutput() {
+		if queueErr != nil {
+			return queueErr
+		}
+		return fmt.Errorf("output still busy, not flushed and closed: %w", ctx.Err())
+	}
+	defer l.unlockOutput()
+	flushErr := l.flushBuffer()
+	var closeErr error
+	if l.closer != nil {
+		closer := l.closer
+		l.closer = nil
+		closeErr = closer.Close()
+	}
+
+	if queueErr != nil {
+		return queueErr
+	}
+	if flushErr != nil {
+		return flushErr
+	}
+	return closeErr
+}
+
+// flushAsync blocks until all entries queued by an async SimpleLogger are written
+func (l *SimpleLogger) flushAsync() {
+	if l.grouped {
+		// the queue was flushed before the group started and is blocked until it ends
+		return
+	}
+	l.mu.Lock()
+	queue := l.async
+	l.mu.Unlock()
+	queue.flush()
+}
+
+func newAsyncQueue(out *output, size int) *asyncQueue {
+	q := &asyncQueue{
+		out:     out,
+		entries: make(chan asyncEntry, size),
+		done:    make(chan struct{}),
+		closing: make(chan struct{}),
+	}
+	go q.run()
+	return q
+}
+
+type asyncEntry struct {
+	pendingWrite
+	flushed chan struct{}
+}
+
+type asyncQueue struct {
+	out     *output
+	entries chan asyncEntry
+	done    chan struct{}
+	// closing is closed once close is called so senders blocked on a full queue give up
+	closing chan struct{}
+	// abandoned is set atomically once close gave up waiting. All remaining entries are dropped afterwards
+	abandoned int32
+
+	// mu guards closed. Senders hold a read lock so entries is never closed while sending
+	mu     sync.RWMutex
+	closed bool
+}
+
+func (q *asyncQueue) run() {
+	defer close(q.done)
+	for entry := range q.entries {
+		if entry.flushed != nil {
+			close(entry.flushed)
+			continue
+		}
+		if atomic.LoadInt32(&q.abandoned) == 1 {
+			atomic.AddUint64(&q.out.dropped, 1)
+			continue
+		}
+		q.out.writeTo(entry.pendingWrite)
+	}
+}
+
+// send queues the entry according to the OverflowPolicy or writes it directly if the asyncQueue is already closed
+func (q *asyncQueue) send(entry asyncEntry, policy OverflowPolicy) {
+	if q.queue(entry, policy) {
+		return
+	}
+	if entry.flushed != nil {
+		close(entry.flushed)
+		return
+	}
+	q.out.writeTo(entry.pendingWrite)
+}
+
+// queue queues the entry according to the OverflowPolicy. It returns false if the asyncQueue is closing
+func (q *asyncQueue) queue(entry asyncEntry, policy OverflowPolicy) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.closed {
+		return false
+	}
+
+	switch policy {
+	case OverflowPolicyDropNewest:
+		select {
+		case q.entries <- entry:
+		default:
+			atomic.AddUint64(&q.out.dropped, 1)
+		}
+	case OverflowPolicyDropOldest:
+		for {
+			select {
+			case q.entries <- entry:
+				return true
+			default:
+			}
+			select {
+			case oldest := <-q.entries:
+				if oldest.flushed != nil {
+					close(oldest.flushed)
+				} else {
+					atomic.AddUint64(&q.out.dropped, 1)
+				}
+			default:
+			}
+		}
+	default:
+		// stop waiting for space once close started so it can acquire mu
+		select {
+		case q.entries <- entry:
+		case <-q.closing:
+			return false
+		}
+	}
+	return true
+}
+
+// flush blocks until all entries queued before it are written
+func (q *asyncQueue) flush() {
+	if q == nil {
+		return
+	}
+	flushed := make(chan struct{})
+	q.send(asyncEntry{flushed: flushed}, OverflowPolicyBlock)
+	<-flushed
+}
+
+// close stops accepting entries and waits until all queued entries are written or ctx is done.
+// If ctx is done first the remaining entries are dropped and their number is returned with ctx.Err()
+func (q *asyncQueue) close(ctx context.Context) (int, error) {
+	close(q.closing)
+	q.mu.Lock()
+	q.closed = true
+	close(q.entries)
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+		return 0, nil
+	case <-ctx.Done():
+		atomic.StoreInt32(&q.abandoned, 1)
+		return len(q.entries), ctx.Err()
+	}
+}
@@ -0,0 +1,92 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowLocker delays each Lock to simulate a slow output
+type slowLocker struct {
+	sync.Mutex
+	delay time.Duration
+}
+
+func (l *slowLocker) Lock() {
+	time.Sleep(l.delay)
+	l.Mutex.Lock()
+}
+
+// closerFunc is an io.Closer calling the func
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
+
+func TestCloseContextTimeoutFlushesAndCloses(t *testing.T) {
+	buf := &lockedBuffer{}
+	l := New(0)
+	l.SetColors(false)
+	l.SetOutput(buf)
+	l.SetBuffered(4096)
+	l.SetAsync(16)
+	l.SetOutputLocker(&slowLocker{delay: 50 * time.Millisecond})
+	// bounds how long CloseContext waits for the write in progress after ctx is done
+	l.SetWriteTimeout(time.Second)
+
+	closed := false
+	l.mu.Lock()
+	l.closer = closerFunc(func() error {
+		closed = true
+		return nil
+	})
+	l.mu.Unlock()
+
+	for i := 0; i < 5; i++ {
+		l.Info("queued")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err := l.CloseContext(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got: %v", err)
+	}
+	if !closed {
+		t.Error("expected the output to be closed")
+	}
+	if got := buf.String(); got == "" {
+		t.Error("expected the buffered entries to be flushed")
+	}
+}
+
+func TestCloseContextHungOutput(t *testing.T) {
+	for _, policy := range []OverflowPolicy{OverflowPolicyBlock, OverflowPolicyDropNewest} {
+		w := &blockingWriter{release: make(chan struct{})}
+		l := New(0)
+		l.SetOutput(w)
+		l.SetAsync(1)
+		l.SetOverflowPolicy(policy)
+
+		// the first entry hangs in the output, the second fills the queue and the third blocks with OverflowPolicyBlock
+		for i := 0; i < 3; i++ {
+			go l.Info("message")
+		}
+		time.Sleep(20 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		start := time.Now()
+		err := l.CloseContext(ctx)
+		cancel()
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("policy %d: expected CloseContext to return after its deadline, took %s", policy, elapsed)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("policy %d: expected context.DeadlineExceeded, got: %v", policy, err)
+		}
+		close(w.release)
+	}
+}
@@ -0,0 +1,149 @@
+package log
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"syscall"
+	"time"
+)
+
+// SetBuffered wraps the default output in a bufio.Writer of the given size. A size of 0 or less disables buffering.
+// Entries of the flush Level set with SetFlushLevel and above flush the buffer immediately. The flush Level defaults to LevelWarn.
+// Call Flush or Close before exiting to make sure all buffered entries are written
+func (l *SimpleLogger) SetBuffered(size int) {
+	defer l.debugSetting("SetBuffered", size)
+	l.flushAsync()
+	l.lockOutput()
+	defer l.unlockOutput()
+
+	_ = l.flushBuffer()
+	if size <= 0 {
+		l.buffer = nil
+		return
+	}
+	l.buffer = bufio.NewWriterSize(l.w, size)
+}
+
+// SetFlushLevel sets the Level at and above which entries flush the buffer of a buffered SimpleLogger immediately.
+// Entries below the Level stay buffered until the buffer is full or Flush is called
+func (l *SimpleLogger) SetFlushLevel(level Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.flushLevel = level
+}
+
+// Flush writes all queued and buffered entries to the output
+func (l *SimpleLogger) Flush() error {
+	l.flushAsync()
+	l.lockOutput()
+	defer l.unlockOutput()
+	return l.flushBuffer()
+}
+
+// lockOutput acquires writeMu and mu in this order. writeMu is already held by the SimpleLogger passed to a Group callback
+func (l *SimpleLogger) lockOutput() {
+	if !l.grouped {
+		l.writeMu.Lock()
+	}
+	l.mu.Lock()
+}
+
+// tryLockOutput is like lockOutput but gives up if writeMu is still held after the write timeout which bounds the write in progress.
+// Without write timeout it only tries once
+func (l *SimpleLogger) tryLockOutput() bool {
+	if !l.grouped {
+		l.mu.Lock()
+		timeout := l.writeTimeout
+		l.mu.Unlock()
+		deadline := time.Now().Add(timeout)
+		for !l.writeMu.TryLock() {
+			if !time.Now().Before(deadline) {
+				return false
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	l.mu.Lock()
+	return true
+}
+
+// unlockOutput releases the locks acquired by lockOutput
+func (l *SimpleLogger) unlockOutput() {
+	l.mu.Unlock()
+	if !l.grouped {
+		l.writeMu.Unlock()
+	}
+}
+
+// flushBuffer flushes the bufio.Writer of a buffered SimpleLogger. The caller must hold writeMu and mu, see lockOutput.
+// It waits for a timed out write which still uses the bufio.Writer, see SetWriteTimeout
+func (l *SimpleLogger) flushBuffer() error {
+	if l.buffer == nil {
+		return nil
+	}
+	release, err := acquireWriting(l.writing, l.writeTimeout)
+	if err != nil {
+		return err
+	}
+	defer release()
+	if l.locker != nil {
+		l.locker.Lock()
+		defer l.locker.Unlock()
+	}
+	return l.buffer.Flush()
+}
+
+// Sync flushes the SimpleLogger and calls Sync on all outputs which implement it like *os.File.
+// This commits written entries to stable storage. Outputs without Sync method and terminals are skipped.
+// Errors of outputs which don't support syncing like pipes are ignored
+func (l *SimpleLogger) Sync() error {
+	if err := l.Flush(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	writers := make([]io.Writer, 0, len(l.levels)+2)
+	writers = append(writers, l.w)
+	for _, w := range l.levels {
+		writers = append(writers, w)
+	}
+	if l.errorStream != nil {
+		writers = append(writers, l.errorStream)
+	}
+	writing, timeout := l.writing, l.writeTimeout
+	l.mu.Unlock()
+
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+	release, err := acquireWriting(writing, timeout)
+	if err != nil {
+		return err
+	}
+	defer release()
+	for _, w := range writers {
+		if err := syncWriter(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncWriter calls Sync on w if it implements it. Character devices like terminals are skipped and
+// EINVAL and ENOTSUP returned by outputs which don't support syncing like pipes are ignored
+func syncWriter(w io.Writer) error {
+	syncer, ok := w.(interface{ Sync() error })
+	if !ok {
+		return nil
+	}
+	if file, ok := w.(*os.File); ok {
+		if info, err := file.Stat(); err == nil && info.Mode()&os.ModeCharDevice != 0 {
+			return nil
+		}
+	}
+	if err := syncer.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, syscall.ENOTSUP) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package log
+
+import (
+	"bytes"
+	"os"
+	"sync"
+	"testing"
+)
+
+// lockedBuffer is a bytes.Buffer which can be written and read concurrently
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestSetOutputBufferedConcurrent(t *testing.T) {
+	l := New(0)
+	l.SetOutput(&lockedBuffer{})
+	l.SetBuffered(64)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 500; j++ {
+				l.Info("message which fills the buffer quickly")
+			}
+		}()
+	}
+	for i := 0; i < 2000; i++ {
+		l.SetOutput(&lockedBuffer{})
+	}
+	wg.Wait()
+	if err := l.Flush(); err != nil {
+		t.Fatalf("Flush returned error: %s", err)
+	}
+}
+
+func TestSyncUnsupportedOutputs(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %s", err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("failed to open %s: %s", os.DevNull, err)
+	}
+	defer devNull.Close()
+
+	for _, output := range []*os.File{w, devNull, os.Stderr} {
+		l := New(0)
+		l.SetOutput(output)
+		if err = l.Sync(); err != nil {
+			t.Errorf("Sync of %s returned error: %s", output.Name(), err)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package log
+
+import (
+	"bytes"
+)
+
+// CaptureOutput redirects all output of the SimpleLogger to an in memory buffer while f runs and returns what was written.
+// The previous outputs are restored afterwards. This is intended for asserting on log output in tests
+func (l *SimpleLogger) CaptureOutput(f func()) string {
+	l.flushAsync()
+	buf := &bytes.Buffer{}
+
+	l.mu.Lock()
+	w, levels, buffer := l.w, l.levels, l.buffer
+	l.w, l.levels, l.buffer = buf, nil, nil
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.w, l.levels, l.buffer = w, levels, buffer
+	}()
+
+	f()
+	l.flushAsync()
+
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+	return buf.String()
+}
+
+// CaptureOutput redirects all output of the default SimpleLogger to an in memory buffer while f runs and returns what was written
+func CaptureOutput(f func()) string {
+	return Default().CaptureOutput(f)
+}
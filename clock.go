@@ -0,0 +1,34 @@
+package log
+
+import (
+	"time"
+)
+
+// Clock provides the current time to the SimpleLogger. It is used for the time of entries and to measure durations
+// so tests can inject a fake Clock which is advanced manually
+type Clock interface {
+	Now() time.Time
+}
+
+// SetClock sets the Clock the SimpleLogger reads the current time from. Passing nil restores the real time clock
+func (l *SimpleLogger) SetClock(clock Clock) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.clock = clock
+}
+
+// now returns the current time of the Clock or the real time if no Clock is set
+func (l *SimpleLogger) now() time.Time {
+	l.configMu.RLock()
+	clock := l.clock
+	l.configMu.RUnlock()
+	if clock != nil {
+		return clock.Now()
+	}
+	return time.Now()
+}
+
+// SetClock sets the Clock of the default Logger
+func SetClock(clock Clock) {
+	Default().SetClock(clock)
+}
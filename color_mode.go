@@ -0,0 +1,45 @@
+package log
+
+import (
+	"os"
+)
+
+// ColorMode controls whether a SimpleLogger renders entries with Style(s)
+type ColorMode int
+
+const (
+	// ColorAuto renders colors if the output is a terminal. NO_COLOR disables and FORCE_COLOR enables colors regardless of the output
+	ColorAuto ColorMode = iota
+	// ColorAlways always renders colors even if the output is no terminal like when piping to less -R
+	ColorAlways
+	// ColorNever never renders colors
+	ColorNever
+)
+
+// noColor and forceColor report whether the NO_COLOR and FORCE_COLOR environment variables are set
+var noColor, forceColor = colorEnv()
+
+// colorEnv reads the NO_COLOR (https://no-color.org) and FORCE_COLOR conventions from the environment
+func colorEnv() (bool, bool) {
+	force := os.Getenv("FORCE_COLOR")
+	return os.Getenv("NO_COLOR") != "", force != "" && force != "0" && force != "false"
+}
+
+// SetColorMode sets whether entries are rendered with Style(s). The precedence is:
+//   - EnableColors set to false disables colors of all SimpleLogger(s)
+//   - ColorNever and ColorAlways disable or enable colors regardless of the output and environment
+//   - ColorAuto disables colors if NO_COLOR is set, enables them if FORCE_COLOR is set and otherwise only renders them on terminals
+//
+// It replaces the settings of SetColors and SetAutoColors
+func (l *SimpleLogger) SetColorMode(mode ColorMode) {
+	defer l.debugSetting("SetColorMode", mode)
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.colors = mode != ColorNever
+	l.autoColors = mode == ColorAuto
+}
+
+// SetColorMode sets whether entries of the default Logger are rendered with Style(s)
+func SetColorMode(mode ColorMode) {
+	Default().SetColorMode(mode)
+}
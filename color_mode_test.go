@@ -0,0 +1,65 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestAutoColorsRedetectOnSetOutput(t *testing.T) {
+	oldForceColor, oldNoColor := forceColor, noColor
+	forceColor, noColor = false, false
+	defer func() {
+		forceColor, noColor = oldForceColor, oldNoColor
+	}()
+
+	l := New(0)
+	l.SetColors(true)
+	l.SetAutoColors(true)
+	// pretend the initial output is a terminal
+	l.mu.Lock()
+	l.terminal = true
+	l.mu.Unlock()
+	if !l.colorsEnabled() {
+		t.Fatal("expected colors on a terminal")
+	}
+
+	buf := &bytes.Buffer{}
+	l.SetOutput(buf)
+	l.Info("message")
+	if strings.Contains(buf.String(), "\x1b[") {
+		t.Errorf("expected no ANSI codes, got %q", buf.String())
+	}
+}
+
+func TestSetOutputStderrKeepsColorChoice(t *testing.T) {
+	oldForceColor, oldNoColor := forceColor, noColor
+	forceColor, noColor = false, false
+	defer func() {
+		forceColor, noColor = oldForceColor, oldNoColor
+	}()
+
+	l := New(0)
+	l.SetOutputStderr()
+	// pretend stderr is a terminal like when the program runs in a console
+	l.mu.Lock()
+	l.terminal = true
+	l.mu.Unlock()
+	if !l.colorsEnabled() {
+		t.Error("expected colors on a terminal")
+	}
+
+	l.SetOutput(&bytes.Buffer{})
+	if l.colorsEnabled() {
+		t.Error("expected no colors after redirecting to a buffer")
+	}
+
+	l.SetColors(false)
+	l.SetOutputStderr()
+	l.mu.Lock()
+	l.terminal = true
+	l.mu.Unlock()
+	if l.colorsEnabled() {
+		t.Error("expected colors disabled with SetColors to stay disabled")
+	}
+}
@@ -0,0 +1,61 @@
+package log
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	componentLevelsMu sync.RWMutex
+	componentLevels   map[string]Level
+)
+
+// WithName returns a copy of the SimpleLogger with the given name appended to its name with a ".".
+// The name is attached as "logger" field and used to look up the Level set with SetComponentLevels.
+// The copy shares its output with the SimpleLogger it was created from
+func (l *SimpleLogger) WithName(name string) *SimpleLogger {
+	clone := l.clone()
+	if clone.name != "" {
+		name = clone.name + "." + name
+	}
+	clone.name = name
+	return clone
+}
+
+// SetComponentLevels sets the Level of named SimpleLogger(s) by their name like {"gateway": LevelDebug, "voice": LevelWarn}.
+// Names are matched by the longest dot separated prefix, so "gateway" also applies to "gateway.shard".
+// Named SimpleLogger(s) without match use their own Level. Passing nil removes all component Level(s)
+func SetComponentLevels(levels map[string]Level) {
+	copied := make(map[string]Level, len(levels))
+	for name, level := range levels {
+		copied[name] = level
+	}
+
+	componentLevelsMu.Lock()
+	defer componentLevelsMu.Unlock()
+	componentLevels = copied
+}
+
+// componentLevel returns the Level of the longest dot separated prefix of name set with SetComponentLevels
+func componentLevel(name string) (Level, bool) {
+	componentLevelsMu.RLock()
+	defer componentLevelsMu.RUnlock()
+	if len(componentLevels) == 0 {
+		return 0, false
+	}
+	for {
+		if level, ok := componentLevels[name]; ok {
+			return level, true
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i == -1 {
+			return 0, false
+		}
+		name = name[:i]
+	}
+}
+
+// WithName returns a copy of the default SimpleLogger with the given name
+func WithName(name string) *SimpleLogger {
+	return Default().WithName(name)
+}
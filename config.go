@@ -0,0 +1,104 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Format is the output format of a SimpleLogger configured with Config
+type Format string
+
+// All Format(s) which Config supports
+const (
+	FormatText   Format = "text"
+	FormatJSON   Format = "json"
+	FormatLogfmt Format = "logfmt"
+)
+
+// DefaultConfig returns the Config of a SimpleLogger created with New(LstdFlags)
+func DefaultConfig() Config {
+	return Config{
+		Level:  LevelInfo,
+		Format: FormatText,
+		Output: "stderr",
+		Colors: true,
+	}
+}
+
+// Config declaratively describes a SimpleLogger. It can be loaded from a config file as all fields are text (un)marshalable.
+// Start with DefaultConfig as the zero value of Level is LevelTrace
+type Config struct {
+	// Level is the lowest Level to Output for
+	Level Level `json:"level" yaml:"level"`
+	// Format is the Format entries are rendered in. Defaults to FormatText
+	Format Format `json:"format" yaml:"format"`
+	// Output is "stderr", "stdout" or the path of a file entries are appended to. Defaults to "stderr"
+	Output string `json:"output" yaml:"output"`
+	// TimeFormat is the layout used to render timestamps. Defaults to the format of the used Formatter
+	TimeFormat string `json:"time_format" yaml:"time_format"`
+	// Colors sets whether entries are rendered with Style(s)
+	Colors bool `json:"colors" yaml:"colors"`
+}
+
+// NewWithConfig returns a new SimpleLogger configured with the given Config
+func NewWithConfig(cfg Config) (*SimpleLogger, error) {
+	l := New(LstdFlags)
+	if err := l.Configure(cfg); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Configure applies the given Config to the SimpleLogger.
+// A file opened for a previous Config is closed after the new output is set
+func (l *SimpleLogger) Configure(cfg Config) error {
+	var formatter Formatter
+	switch cfg.Format {
+	case FormatText, "":
+		formatter = &TextFormatter{TimeFormat: cfg.TimeFormat}
+	case FormatJSON:
+		formatter = &JSONFormatter{TimeFormat: cfg.TimeFormat}
+	case FormatLogfmt:
+		formatter = &LogfmtFormatter{TimeFormat: cfg.TimeFormat}
+	default:
+		return fmt.Errorf("unknown format: %q", cfg.Format)
+	}
+
+	var (
+		w      io.Writer
+		closer io.Closer
+	)
+	switch cfg.Output {
+	case "stderr", "":
+		w = os.Stderr
+	case "stdout":
+		w = os.Stdout
+	default:
+		file, err := os.OpenFile(cfg.Output, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open log file: %w", err)
+		}
+		w = file
+		closer = file
+	}
+
+	l.SetLevel(cfg.Level)
+	l.SetFormatter(formatter)
+	l.SetColors(cfg.Colors)
+	l.SetOutput(w)
+
+	l.mu.Lock()
+	oldCloser := l.closer
+	l.closer = closer
+	l.mu.Unlock()
+	if oldCloser != nil {
+		return oldCloser.Close()
+	}
+	return nil
+}
+
+// Configure applies the given Config to the default SimpleLogger
+func Configure(cfg Config) error {
+	return Default().Configure(cfg)
+}
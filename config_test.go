@@ -0,0 +1,57 @@
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestConfigureFileThenSetAsync(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	cfg := DefaultConfig()
+	cfg.Output = path
+	cfg.Colors = false
+
+	l, err := NewWithConfig(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.SetAsync(8)
+	l.SetAsync(8)
+	l.Info("message")
+	if err := l.Close(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "INFO  message") {
+		t.Errorf("expected the entry in the log file, got %q", data)
+	}
+}
+
+func TestLeveledFilesThenSetAsync(t *testing.T) {
+	dir := t.TempDir()
+	l, _, err := NewLeveledFiles(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.SetAsync(8)
+	l.Error("message")
+	if err := l.Close(); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	for _, name := range []string{"all.log", "error.log"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(data), "message") {
+			t.Errorf("expected the entry in %s, got %q", name, data)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"sync/atomic"
+)
+
+var _ io.Writer = (*CountingWriter)(nil)
+
+// NewCountingWriter returns a CountingWriter which counts the bytes and lines written to w
+func NewCountingWriter(w io.Writer) *CountingWriter {
+	return &CountingWriter{w: w}
+}
+
+// CountingWriter is an io.Writer which counts the bytes and newline terminated lines written to the underlying io.Writer
+type CountingWriter struct {
+	// bytes and lines are accessed atomically and need to be 64-bit aligned
+	bytes uint64
+	lines uint64
+	w     io.Writer
+}
+
+// Write writes p to the underlying io.Writer and counts the written bytes and lines
+func (w *CountingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	atomic.AddUint64(&w.bytes, uint64(n))
+	atomic.AddUint64(&w.lines, uint64(bytes.Count(p[:n], []byte{'\n'})))
+	return n, err
+}
+
+// Stats returns the number of bytes and lines written so far
+func (w *CountingWriter) Stats() (bytes uint64, lines uint64) {
+	return atomic.LoadUint64(&w.bytes), atomic.LoadUint64(&w.lines)
+}
+
+// Stats returns the number of bytes and lines written to all CountingWriter(s) used as output of the SimpleLogger.
+// It returns 0, 0 if no output is a CountingWriter
+func (l *SimpleLogger) Stats() (bytes uint64, lines uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	seen := map[*CountingWriter]struct{}{}
+	count := func(w io.Writer) {
+		cw, ok := w.(*CountingWriter)
+		if !ok {
+			return
+		}
+		if _, ok = seen[cw]; ok {
+			return
+		}
+		seen[cw] = struct{}{}
+		b, n := cw.Stats()
+		bytes += b
+		lines += n
+	}
+	count(l.w)
+	for _, w := range l.levels {
+		count(w)
+	}
+	return bytes, lines
+}
@@ -0,0 +1,63 @@
+//go:build !release
+
+package log
+
+// Trace logs on the LevelTrace
+func (l *SimpleLogger) Trace(v ...any) {
+	l.Output(3, LevelTrace, v...)
+}
+
+// Tracef logs on the LevelTrace
+func (l *SimpleLogger) Tracef(format string, v ...any) {
+	l.Outputf(3, LevelTrace, format, v...)
+}
+
+// Debug logs on the LevelDebug
+func (l *SimpleLogger) Debug(v ...any) {
+	l.Output(3, LevelDebug, v...)
+}
+
+// Debugf logs on the LevelDebug
+func (l *SimpleLogger) Debugf(format string, v ...any) {
+	l.Outputf(3, LevelDebug, format, v...)
+}
+
+// TraceFields logs on the LevelTrace with the given Fields added to this entry only
+func (l *SimpleLogger) TraceFields(fields Fields, v ...any) {
+	l.OutputFields(3, LevelTrace, fields, v...)
+}
+
+// DebugFields logs on the LevelDebug with the given Fields added to this entry only
+func (l *SimpleLogger) DebugFields(fields Fields, v ...any) {
+	l.OutputFields(3, LevelDebug, fields, v...)
+}
+
+// Trace logs on the LevelTrace with the default SimpleLogger
+func Trace(v ...any) {
+	Output(3, LevelTrace, v...)
+}
+
+// Tracef logs on the LevelTrace with the default SimpleLogger
+func Tracef(format string, v ...any) {
+	Outputf(3, LevelTrace, format, v...)
+}
+
+// Debug logs on the LevelDebug with the default SimpleLogger
+func Debug(v ...any) {
+	Output(3, LevelDebug, v...)
+}
+
+// Debugf logs on the LevelDebug with the default SimpleLogger
+func Debugf(format string, v ...any) {
+	Outputf(3, LevelDebug, format, v...)
+}
+
+// TraceFields logs on the LevelTrace with the given Fields with the default SimpleLogger
+func TraceFields(fields Fields, v ...any) {
+	OutputFields(3, LevelTrace, fields, v...)
+}
+
+// DebugFields logs on the LevelDebug with the given Fields with the default SimpleLogger
+func DebugFields(fields Fields, v ...any) {
+	OutputFields(3, LevelDebug, fields, v...)
+}
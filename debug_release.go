@@ -0,0 +1,43 @@
+//go:build release
+
+package log
+
+// Builds with the release tag compile out all Trace and Debug logging with zero runtime cost:
+//
+//	go build -tags release
+
+// Trace does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func (l *SimpleLogger) Trace(v ...any) {}
+
+// Tracef does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func (l *SimpleLogger) Tracef(format string, v ...any) {}
+
+// Debug does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func (l *SimpleLogger) Debug(v ...any) {}
+
+// Debugf does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func (l *SimpleLogger) Debugf(format string, v ...any) {}
+
+// TraceFields does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func (l *SimpleLogger) TraceFields(fields Fields, v ...any) {}
+
+// DebugFields does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func (l *SimpleLogger) DebugFields(fields Fields, v ...any) {}
+
+// Trace does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func Trace(v ...any) {}
+
+// Tracef does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func Tracef(format string, v ...any) {}
+
+// Debug does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func Debug(v ...any) {}
+
+// Debugf does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func Debugf(format string, v ...any) {}
+
+// TraceFields does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func TraceFields(fields Fields, v ...any) {}
+
+// DebugFields does nothing as LevelTrace and LevelDebug are compiled out in builds with the release tag
+func DebugFields(fields Fields, v ...any) {}
@@ -0,0 +1,25 @@
+package log
+
+import (
+	"sync/atomic"
+)
+
+// LogIfEnabled logs msg on the given Level with l if the Level is enabled.
+// Unlike calling the methods through the Logger interface this is a direct call which the compiler can inline,
+// so disabled entries only cost a single comparison in hot paths.
+// Entries skipped by this comparison are not counted by Suppressed to keep the function within the inlining budget
+func LogIfEnabled(l *SimpleLogger, level Level, msg string) {
+	// named SimpleLogger(s) can have a lower component Level so they always take the slow path
+	if int32(level) >= atomic.LoadInt32(&(*LevelVar)(atomic.LoadPointer(&l.level)).level) || len(l.name) > 0 {
+		l.logIfEnabled(level, msg)
+	}
+}
+
+// logIfEnabled is the slow path of LogIfEnabled which is kept out of line so LogIfEnabled can be inlined
+func (l *SimpleLogger) logIfEnabled(level Level, msg string) {
+	if !l.Enabled(level) {
+		atomic.AddUint64(&l.suppressed, 1)
+		return
+	}
+	l.log(3, l.now(), level, msg, nil)
+}
@@ -0,0 +1,31 @@
+package log
+
+import (
+	"io"
+	"testing"
+)
+
+func BenchmarkLogIfEnabled(b *testing.B) {
+	l := New(0)
+	l.SetOutput(io.Discard)
+	l.SetLevel(LevelWarn)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LogIfEnabled(l, LevelDebug, "disabled")
+	}
+}
+
+func BenchmarkLoggerInterface(b *testing.B) {
+	l := New(0)
+	l.SetOutput(io.Discard)
+	l.SetLevel(LevelWarn)
+	var logger Logger = l
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Debug("disabled")
+	}
+}
@@ -0,0 +1,84 @@
+package log
+
+import (
+	"sort"
+	"strings"
+)
+
+// FieldOrder defines in which order Fields are rendered
+type FieldOrder int
+
+// All FieldOrder(s) which SimpleLogger supports
+const (
+	// FieldOrderSorted renders Fields sorted by key
+	FieldOrderSorted FieldOrder = iota
+	// FieldOrderInsertion renders Fields in the order they were added with WithField, WithFields, With and the per entry Fields methods.
+	// Default Fields, the logger name and Fields added before the FieldOrder was set are rendered first sorted by key
+	FieldOrderInsertion
+)
+
+// SetFieldOrder sets the FieldOrder in which Fields are rendered. It applies to Fields added afterwards
+func (l *SimpleLogger) SetFieldOrder(order FieldOrder) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.fieldOrder = order
+}
+
+// appendFieldKeys returns a copy of order with the keys of fields in the current group appended if they are not already present.
+// The keys are appended in the order of keys or sorted if keys is nil
+func (l *SimpleLogger) appendFieldKeys(order []string, fields Fields, keys []string) []string {
+	if keys == nil {
+		keys = orderedKeys(fields, "", nil)
+	}
+	prefix := ""
+	if len(l.groups) > 0 {
+		prefix = strings.Join(l.groups, ".") + "."
+	}
+
+	appended := make([]string, len(order), len(order)+len(keys))
+	copy(appended, order)
+outer:
+	for _, key := range keys {
+		key = prefix + key
+		for _, existing := range appended {
+			if existing == key {
+				continue outer
+			}
+		}
+		appended = append(appended, key)
+	}
+	return appended
+}
+
+// orderedKeys returns the keys of fields at the given group prefix in the given order.
+// Keys not present in order are returned first sorted by key. If order is nil all keys are sorted
+func orderedKeys(fields Fields, prefix string, order []string) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if order == nil {
+		return keys
+	}
+
+	rank := make(map[string]int, len(keys))
+	for _, key := range keys {
+		rank[key] = -1
+		for i, path := range order {
+			if path == prefix+key || strings.HasPrefix(path, prefix+key+".") {
+				rank[key] = i
+				break
+			}
+		}
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		return rank[keys[i]] < rank[keys[j]]
+	})
+	return keys
+}
+
+// SetFieldOrder sets the FieldOrder in which Fields of the default Logger are rendered
+func SetFieldOrder(order FieldOrder) {
+	Default().SetFieldOrder(order)
+}
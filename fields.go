@@ -0,0 +1,225 @@
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Fields are key value pairs which are attached to each entry
+type Fields map[string]any
+
+// WithField returns a copy of the SimpleLogger which attaches the given field to each entry.
+// The copy shares its output with the SimpleLogger it was created from
+func (l *SimpleLogger) WithField(key string, value any) *SimpleLogger {
+	return l.WithFields(Fields{key: value})
+}
+
+// WithFields returns a copy of the SimpleLogger which attaches the given Fields to each entry.
+// The copy shares its output with the SimpleLogger it was created from
+func (l *SimpleLogger) WithFields(fields Fields) *SimpleLogger {
+	return l.withFields(fields, nil)
+}
+
+// withFields returns a copy of the SimpleLogger with the given fields added in the order of keys.
+// If keys is nil the fields are added sorted by key
+func (l *SimpleLogger) withFields(fields Fields, keys []string) *SimpleLogger {
+	clone := l.clone()
+	clone.fields = mergeFields(clone.fields, clone.groups, fields)
+	if clone.fieldOrder == FieldOrderInsertion {
+		clone.fieldKeys = clone.appendFieldKeys(clone.fieldKeys, fields, keys)
+	}
+	return clone
+}
+
+// With returns a copy of the SimpleLogger which attaches the given alternating keys and values to each entry.
+// A trailing value without key is attached with the key "!BADKEY".
+// The copy shares its output with the SimpleLogger it was created from
+func (l *SimpleLogger) With(keysAndValues ...any) *SimpleLogger {
+	fields := keysAndValuesToFields(keysAndValues)
+	keys := make([]string, 0, len(fields))
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		keys = append(keys, fmt.Sprint(keysAndValues[i]))
+	}
+	if len(keysAndValues)%2 == 1 {
+		keys = append(keys, "!BADKEY")
+	}
+	return l.withFields(fields, keys)
+}
+
+// WithGroup returns a copy of the SimpleLogger which nests all fields added afterwards under the given group name.
+// Groups can be nested by calling WithGroup multiple times.
+// The copy shares its output with the SimpleLogger it was created from
+func (l *SimpleLogger) WithGroup(name string) *SimpleLogger {
+	clone := l.clone()
+	clone.groups = append(clone.groups[:len(clone.groups):len(clone.groups)], name)
+	return clone
+}
+
+// mergeFields returns a copy of base with fields added to the group at the given path
+func mergeFields(base Fields, groups []string, fields Fields) Fields {
+	merged := make(Fields, len(base)+len(fields))
+	for key, value := range base {
+		merged[key] = value
+	}
+	if len(groups) == 0 {
+		overrideFields(merged, fields)
+		return merged
+	}
+	group, _ := merged[groups[0]].(Fields)
+	merged[groups[0]] = mergeFields(group, groups[1:], fields)
+	return merged
+}
+
+// SetDefaultFields sets the Fields which are attached to each entry of the SimpleLogger.
+// Fields added via WithField or WithFields take precedence over the default Fields
+func (l *SimpleLogger) SetDefaultFields(fields Fields) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.defaultFields = fields
+}
+
+// keysAndValuesToFields converts alternating keys and values to Fields.
+// A value without key is attached with the key "!BADKEY"
+func keysAndValuesToFields(keysAndValues []any) Fields {
+	fields := make(Fields, (len(keysAndValues)+1)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 == len(keysAndValues) {
+			fields["!BADKEY"] = keysAndValues[i]
+			break
+		}
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			key = fmt.Sprint(keysAndValues[i])
+		}
+		fields[key] = keysAndValues[i+1]
+	}
+	return fields
+}
+
+// entryFields returns the Fields of an entry. The given fields of a single entry are added to the current group
+func (l *SimpleLogger) entryFields(fields Fields) Fields {
+	if len(l.defaultFields) == 0 && l.name == "" && len(fields) == 0 {
+		return l.fields
+	}
+	entryFields := make(Fields, len(l.defaultFields)+len(l.fields)+1)
+	overrideFields(entryFields, l.defaultFields)
+	if l.name != "" {
+		entryFields["logger"] = l.name
+	}
+	overrideFields(entryFields, l.fields)
+	if len(fields) > 0 {
+		return mergeFields(entryFields, l.groups, fields)
+	}
+	return entryFields
+}
+
+// OutputFields logs v formatted with fmt.Sprint on the given Level with the given Fields added to this entry only.
+// Unlike WithFields(fields).Output this does not copy the SimpleLogger
+func (l *SimpleLogger) OutputFields(calldepth int, level Level, fields Fields, v ...any) {
+	if !l.Enabled(level) {
+		atomic.AddUint64(&l.suppressed, 1)
+		return
+	}
+	l.log(calldepth, l.now(), level, fmt.Sprint(v...), fields)
+}
+
+// InfoFields logs on the LevelInfo with the given Fields added to this entry only
+func (l *SimpleLogger) InfoFields(fields Fields, v ...any) {
+	l.OutputFields(3, LevelInfo, fields, v...)
+}
+
+// WarnFields logs on the LevelWarn with the given Fields added to this entry only
+func (l *SimpleLogger) WarnFields(fields Fields, v ...any) {
+	l.OutputFields(3, LevelWarn, fields, v...)
+}
+
+// ErrorFields logs on the LevelError with the given Fields added to this entry only
+func (l *SimpleLogger) ErrorFields(fields Fields, v ...any) {
+	l.OutputFields(3, LevelError, fields, v...)
+}
+
+// overrideFields sets all fields of src in dst so each key only exists once with the value of src.
+// Groups present in both are merged instead of replaced
+func overrideFields(dst Fields, src Fields) {
+	for key, value := range src {
+		if group, ok := value.(Fields); ok {
+			if dstGroup, ok := dst[key].(Fields); ok {
+				merged := make(Fields, len(dstGroup)+len(group))
+				overrideFields(merged, dstGroup)
+				overrideFields(merged, group)
+				dst[key] = merged
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+// formatFields renders the Fields of the Entry as " key=value" pairs
+func formatFields(entry Entry) string {
+	var b strings.Builder
+	entry.walkFields(func(key string, value any) {
+		b.WriteString(" ")
+		b.WriteString(key)
+		b.WriteString("=")
+		b.WriteString(entry.formatValue(value))
+	})
+	return b.String()
+}
+
+// walkFields calls fn for each field in the order of orderedKeys. Fields of groups are flattened to prefix.group.key
+func walkFields(fields Fields, prefix string, order []string, fn func(key string, value any)) {
+	for _, key := range orderedKeys(fields, prefix, order) {
+		if group, ok := fields[key].(Fields); ok {
+			walkFields(group, prefix+key+".", order, fn)
+			continue
+		}
+		fn(prefix+key, fields[key])
+	}
+}
+
+// WithField returns a copy of the default SimpleLogger which attaches the given field to each entry
+func WithField(key string, value any) *SimpleLogger {
+	return Default().WithField(key, value)
+}
+
+// WithFields returns a copy of the default SimpleLogger which attaches the given Fields to each entry
+func WithFields(fields Fields) *SimpleLogger {
+	return Default().WithFields(fields)
+}
+
+// With returns a copy of the default SimpleLogger which attaches the given alternating keys and values to each entry
+func With(keysAndValues ...any) *SimpleLogger {
+	return Default().With(keysAndValues...)
+}
+
+// WithGroup returns a copy of the default SimpleLogger which nests all fields added afterwards under the given group name
+func WithGroup(name string) *SimpleLogger {
+	return Default().WithGroup(name)
+}
+
+// SetDefaultFields sets the Fields which are attached to each entry of the default SimpleLogger
+func SetDefaultFields(fields Fields) {
+	Default().SetDefaultFields(fields)
+}
+
+// OutputFields logs v formatted with fmt.Sprint on the given Level with the given Fields added to this entry only with the default SimpleLogger
+func OutputFields(calldepth int, level Level, fields Fields, v ...any) {
+	std.OutputFields(calldepth+1, level, fields, v...)
+}
+
+// InfoFields logs on the LevelInfo with the given Fields with the default SimpleLogger
+func InfoFields(fields Fields, v ...any) {
+	OutputFields(3, LevelInfo, fields, v...)
+}
+
+// WarnFields logs on the LevelWarn with the given Fields with the default SimpleLogger
+func WarnFields(fields Fields, v ...any) {
+	OutputFields(3, LevelWarn, fields, v...)
+}
+
+// ErrorFields logs on the LevelError with the given Fields with the default SimpleLogger
+func ErrorFields(fields Fields, v ...any) {
+	OutputFields(3, LevelError, fields, v...)
+}
@@ -0,0 +1,19 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestFieldOverrideChain(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := New(0)
+	l.SetColors(false)
+	l.SetOutput(buf)
+	l.SetDefaultFields(Fields{"a": 1, "b": 1, "c": 1})
+
+	l.WithFields(Fields{"a": 2, "b": 2}).WithField("a", 3).Info("message")
+	if want := "INFO  message a=3 b=2 c=1\n"; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+}
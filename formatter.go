@@ -0,0 +1,305 @@
+package log
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var _ Formatter = (*TextFormatter)(nil)
+
+// Entry is a single log entry which is rendered by a Formatter
+type Entry struct {
+	// Time is already converted to the time zone of the SimpleLogger
+	Time    time.Time
+	Level   Level
+	Message string
+	Fields  Fields
+	// Flags are the Output flags of the SimpleLogger which created the Entry
+	Flags int
+	// Colors reports whether the Entry should be rendered with Style(s)
+	Colors bool
+	// Sequence is the monotonically increasing number of the Entry. It is only set if Flags contain Lsequence
+	Sequence uint64
+	// EpochUnit is the unit timestamps are rendered in as Unix epoch. If 0 timestamps are rendered as date and time
+	EpochUnit time.Duration
+	// File and Line are only set if Flags contain Llongfile or Lshortfile
+	File string
+	Line int
+	// Width is the width of the terminal the Entry is written to if field alignment is enabled with SetAlignFields. Otherwise it is 0
+	Width int
+	// FieldOrder are the keys of the Fields in insertion order like group.key if SetFieldOrder is FieldOrderInsertion.
+	// If nil the Fields are rendered sorted by key
+	FieldOrder []string
+	// BytesEncoding is the encoding []byte field values are rendered in
+	BytesEncoding BytesEncoding
+	// MaxValueLength is the maximum length of rendered field values like strings, errors and []byte. If 0 they are not truncated
+	MaxValueLength int
+	// ValueFormatter renders field values in text, logfmt and JSON output. If nil field values are rendered with fmt.Sprint
+	ValueFormatter func(value any) string
+	// LevelFormat renders the level decoration of the TextFormatter including the separator to the message.
+	// If nil the Level name followed by a space is rendered
+	LevelFormat func(level Level) string
+}
+
+// DefaultValue can be returned by a value formatter set with SetValueFormatter to render the value with fmt.Sprint
+const DefaultValue = "\x00default"
+
+// BytesEncoding defines how []byte field values are rendered
+type BytesEncoding int
+
+// All BytesEncoding(s) which SimpleLogger supports
+const (
+	// BytesEncodingHex renders []byte as lowercase hex like 0a1b
+	BytesEncodingHex BytesEncoding = iota
+	// BytesEncodingBase64 renders []byte as standard base64 like Chs=
+	BytesEncodingBase64
+)
+
+// Epoch returns the Time of the Entry as Unix epoch in the EpochUnit
+func (e Entry) Epoch() int64 {
+	return e.Time.UnixNano() / int64(e.EpochUnit)
+}
+
+// walkFields calls fn for each field of the Entry in the FieldOrder. Fields of groups are flattened to group.key
+func (e Entry) walkFields(fn func(key string, value any)) {
+	walkFields(e.Fields, "", e.FieldOrder, fn)
+}
+
+// formatValue renders a field value with the ValueFormatter of the Entry and truncates it to MaxValueLength
+func (e Entry) formatValue(value any) string {
+	if e.ValueFormatter != nil {
+		if s := e.ValueFormatter(value); s != DefaultValue {
+			return e.truncateValue(s)
+		}
+	}
+	if b, ok := value.([]byte); ok {
+		return e.formatBytes(b)
+	}
+	return e.truncateValue(fmt.Sprint(value))
+}
+
+// formatBytes renders b in the BytesEncoding of the Entry and truncates it to MaxValueLength
+func (e Entry) formatBytes(b []byte) string {
+	var s string
+	switch e.BytesEncoding {
+	case BytesEncodingBase64:
+		s = base64.StdEncoding.EncodeToString(b)
+	default:
+		s = hex.EncodeToString(b)
+	}
+	return e.truncateValue(s)
+}
+
+// truncateValue cuts a rendered field value to MaxValueLength
+func (e Entry) truncateValue(s string) string {
+	if e.MaxValueLength > 0 && len(s) > e.MaxValueLength {
+		return truncateMessage(s, e.MaxValueLength)
+	}
+	return s
+}
+
+// Formatter renders an Entry to the bytes written to the output
+type Formatter interface {
+	Format(entry Entry) ([]byte, error)
+}
+
+// FormatFunc is a lightweight alternative to a Formatter which renders a single entry
+type FormatFunc func(level Level, time time.Time, msg string, fields Fields) []byte
+
+// TextFormatter renders entries like the std Logger with a colored Level in front of the message.
+// The PrefixStyle is rendered at the beginning of the line or right before the Level if Lmsgprefix is set.
+// The Level is always rendered immediately before the message regardless of the flags unless Lnolevel is set
+type TextFormatter struct {
+	// TimeFormat is the layout used to render the time if Ldate, Ltime or Lmicroseconds is set.
+	// Defaults to the format of the std Logger
+	TimeFormat string
+	// Prefix is rendered at the beginning of the line or right before the Level if Lmsgprefix is set like the prefix of the std Logger
+	Prefix string
+}
+
+// Format renders the Entry as a single line of text
+func (f *TextFormatter) Format(entry Entry) ([]byte, error) {
+	prefix := ""
+	var levelStr string
+	if entry.LevelFormat != nil {
+		levelStr = entry.LevelFormat(entry.Level)
+	} else {
+		levelStr = entry.Level.String()
+		if entry.Flags&Lshortlevel != 0 {
+			levelStr = entry.Level.shortString()
+		}
+		levelStr += " "
+	}
+	if entry.Flags&Lnolevel != 0 {
+		levelStr = ""
+	}
+	textStyleStr := ""
+	endStyleStr := ""
+	if entry.Colors {
+		prefix = PrefixStyle.String()
+		if levelStr != "" {
+			levelStr = LevelStyle.And(Styles[entry.Level]).Apply(levelStr)
+		}
+		textStyleStr = TextStyle.String()
+		endStyleStr = StyleReset.String()
+	}
+
+	var buf []byte
+	formatHeader(&buf, entry, prefix+f.Prefix, f.TimeFormat)
+	buf = append(buf, levelStr...)
+	buf = append(buf, textStyleStr...)
+	buf = append(buf, entry.Message...)
+	if entry.Width > 0 {
+		buf = alignFields(buf, entry)
+	} else {
+		buf = append(buf, formatFields(entry)...)
+	}
+	buf = append(buf, endStyleStr...)
+	if len(buf) == 0 || buf[len(buf)-1] != '\n' {
+		buf = append(buf, '\n')
+	}
+	return buf, nil
+}
+
+// alignFieldsColumn is the column fields start at if the terminal is wide enough
+const alignFieldsColumn = 48
+
+// alignFields writes the fields of the Entry to buf starting at alignFieldsColumn.
+// Fields which don't fit into the terminal width are wrapped onto indented lines
+func alignFields(buf []byte, entry Entry) []byte {
+	if len(entry.Fields) == 0 {
+		return buf
+	}
+	column := visibleLen(buf)
+	indent := 4
+	if alignFieldsColumn*2 <= entry.Width {
+		indent = alignFieldsColumn
+		for ; column < alignFieldsColumn; column++ {
+			buf = append(buf, ' ')
+		}
+	}
+	entry.walkFields(func(key string, value any) {
+		field := key + "=" + entry.formatValue(value)
+		if column+1+len(field) > entry.Width && column > indent {
+			buf = append(buf, '\n')
+			for column = 0; column < indent-1; column++ {
+				buf = append(buf, ' ')
+			}
+		}
+		buf = append(buf, ' ')
+		buf = append(buf, field...)
+		column += 1 + len(field)
+	})
+	return buf
+}
+
+// visibleLen returns the number of runes in the last line of buf excluding ANSI escape sequences
+func visibleLen(buf []byte) int {
+	n := 0
+	escape := false
+	for _, r := range string(buf) {
+		switch {
+		case escape:
+			escape = r != 'm'
+		case r == '\x1b':
+			escape = true
+		case r == '\n':
+			n = 0
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// formatHeader writes the prefix, date, time and caller of the Entry to buf like the std Logger does
+func formatHeader(buf *[]byte, entry Entry, prefix string, timeFormat string) {
+	flags := entry.Flags
+	if flags&Lmsgprefix == 0 {
+		*buf = append(*buf, prefix...)
+	}
+	if flags&Lpid != 0 {
+		*buf = append(*buf, '[')
+		*buf = strconv.AppendInt(*buf, int64(pid), 10)
+		*buf = append(*buf, "] "...)
+	}
+	if flags&Lsequence != 0 {
+		*buf = strconv.AppendUint(*buf, entry.Sequence, 10)
+		*buf = append(*buf, ' ')
+	}
+	if flags&timeFlags != 0 && entry.EpochUnit != 0 {
+		*buf = strconv.AppendInt(*buf, entry.Epoch(), 10)
+		*buf = append(*buf, ' ')
+	} else if flags&timeFlags != 0 && timeFormat != "" {
+		*buf = entry.Time.AppendFormat(*buf, timeFormat)
+		*buf = append(*buf, ' ')
+	} else if flags&timeFlags != 0 {
+		t := entry.Time
+		if flags&Ldate != 0 {
+			year, month, day := t.Date()
+			itoa(buf, year, 4)
+			*buf = append(*buf, '/')
+			itoa(buf, int(month), 2)
+			*buf = append(*buf, '/')
+			itoa(buf, day, 2)
+			*buf = append(*buf, ' ')
+		}
+		if flags&(Ltime|Lmicroseconds|Lnanoseconds) != 0 {
+			hour, min, sec := t.Clock()
+			itoa(buf, hour, 2)
+			*buf = append(*buf, ':')
+			itoa(buf, min, 2)
+			*buf = append(*buf, ':')
+			itoa(buf, sec, 2)
+			if flags&Lnanoseconds != 0 {
+				*buf = append(*buf, '.')
+				itoa(buf, t.Nanosecond(), 9)
+			} else if flags&Lmicroseconds != 0 {
+				*buf = append(*buf, '.')
+				itoa(buf, t.Nanosecond()/1e3, 6)
+			}
+			*buf = append(*buf, ' ')
+		}
+	}
+	if flags&(Lshortfile|Llongfile) != 0 {
+		file := entry.File
+		if flags&Lshortfile != 0 {
+			file = shortFile(file)
+		}
+		*buf = append(*buf, file...)
+		*buf = append(*buf, ':')
+		itoa(buf, entry.Line, -1)
+		*buf = append(*buf, ": "...)
+	}
+	if flags&Lmsgprefix != 0 {
+		*buf = append(*buf, prefix...)
+	}
+}
+
+// shortFile returns the final element of the file path
+func shortFile(file string) string {
+	for i := len(file) - 1; i > 0; i-- {
+		if file[i] == '/' {
+			return file[i+1:]
+		}
+	}
+	return file
+}
+
+// itoa converts i to a fixed-width decimal ASCII. Give a negative width to avoid zero-padding
+func itoa(buf *[]byte, i int, wid int) {
+	var b [20]byte
+	bp := len(b) - 1
+	for i >= 10 || wid > 1 {
+		wid--
+		q := i / 10
+		b[bp] = byte('0' + i - q*10)
+		bp--
+		i = q
+	}
+	b[bp] = byte('0' + i)
+	*buf = append(*buf, b[bp:]...)
+}
@@ -0,0 +1,120 @@
+package log
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLevelLabels(t *testing.T) {
+	short := map[string]Level{}
+	for _, level := range Levels() {
+		if label := level.String(); len(label) != 5 {
+			t.Errorf("expected 5 character label for %s, got %q", level.name(), label)
+		}
+		letter := level.shortString()
+		if other, ok := short[letter]; ok {
+			t.Errorf("%s and %s share the short label %q", level.name(), other.name(), letter)
+		}
+		short[letter] = level
+	}
+	if LevelDPanic.name() != "dpanic" {
+		t.Errorf("expected name dpanic, got %q", LevelDPanic.name())
+	}
+}
+
+func TestMaxMessageLengthTruncatesFieldValues(t *testing.T) {
+	huge := strings.Repeat("x", 1000)
+
+	buf := &bytes.Buffer{}
+	l := New(0)
+	l.SetColors(false)
+	l.SetOutput(buf)
+	l.SetMaxMessageLength(10)
+	l.WithField("payload", huge).Info("message")
+	if want := "INFO  message payload=xxxxxxxxxx…(truncated)\n"; buf.String() != want {
+		t.Errorf("expected %q, got %q", want, buf.String())
+	}
+
+	buf.Reset()
+	l.SetFormatter(&JSONFormatter{})
+	l.SetFlags(Lnotimestamp)
+	l.WithField("payload", huge).WithField("err", errors.New(huge)).Info("message")
+	if got := buf.String(); strings.Contains(got, huge[:11]) || strings.Count(got, "…(truncated)") != 2 {
+		t.Errorf("expected the field values to be truncated, got %q", got)
+	}
+}
+
+// fixedClock is a Clock which always returns the same time
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func TestLmsgprefixPlacesLevelBeforeMessage(t *testing.T) {
+	clock := fixedClock(time.Date(2009, 1, 23, 1, 23, 23, 0, time.Local))
+	tests := []struct {
+		flags int
+		want  string
+	}{
+		{flags: 0, want: "app: INFO  message\n"},
+		{flags: Lmsgprefix, want: "app: INFO  message\n"},
+		{flags: Ldate | Ltime, want: "app: 2009/01/23 01:23:23 INFO  message\n"},
+		{flags: Ldate | Ltime | Lmsgprefix, want: "2009/01/23 01:23:23 app: INFO  message\n"},
+		{flags: Ltime | Lshortfile | Lmsgprefix, want: "01:23:23 formatter_test.go:%d: app: INFO  message\n"},
+		{flags: Ldate | Ltime | Lmsgprefix | Lshortlevel, want: "2009/01/23 01:23:23 app: I message\n"},
+		{flags: Ldate | Ltime | Lmsgprefix | Lnolevel, want: "2009/01/23 01:23:23 app: message\n"},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		l := New(tt.flags)
+		l.SetColors(false)
+		l.SetOutput(buf)
+		l.SetClock(clock)
+		l.SetFormatter(&TextFormatter{Prefix: "app: "})
+
+		l.Info("message")
+		_, _, line, _ := runtime.Caller(0)
+		want := tt.want
+		if strings.Contains(want, "%d") {
+			want = fmt.Sprintf(want, line-1)
+		}
+		if got := buf.String(); got != want {
+			t.Errorf("flags %d: expected %q, got %q", tt.flags, want, got)
+		}
+	}
+}
+
+func TestLevelUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data    string
+		want    Level
+		wantErr bool
+	}{
+		{data: `"fatal"`, want: LevelFatal},
+		{data: `"dpanic"`, want: LevelDPanic},
+		{data: `4`, want: LevelError},
+		{data: `7`, want: LevelPanic},
+		{data: `5`, wantErr: true},
+		{data: `6`, wantErr: true},
+		{data: `42`, wantErr: true},
+	}
+	for _, tt := range tests {
+		var level Level
+		err := level.UnmarshalJSON([]byte(tt.data))
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s", tt.data, level.name())
+			}
+			continue
+		}
+		if err != nil || level != tt.want {
+			t.Errorf("%s: expected %s, got %s, %v", tt.data, tt.want.name(), level.name(), err)
+		}
+	}
+}
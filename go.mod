@@ -1,3 +1,7 @@
 module github.com/disgoorg/log
 
 go 1.18
+
+require golang.org/x/term v0.5.0
+
+require golang.org/x/sys v0.5.0 // indirect
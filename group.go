@@ -0,0 +1,22 @@
+package log
+
+// Group calls f with a SimpleLogger which writes all entries while holding the write lock of the output.
+// This keeps all entries logged inside f contiguous without interleaving entries from other goroutines.
+// Entries of async SimpleLogger(s) are written directly inside f.
+//
+// All other logging to the same output blocks until f returns, so f must not block or wait on other goroutines which log.
+// The SimpleLogger passed to f and copies created from it must not be used after f returns and Close must not be called inside f.
+func (l *SimpleLogger) Group(f func(l *SimpleLogger)) {
+	l.flushAsync()
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+
+	grouped := l.clone()
+	grouped.grouped = true
+	f(grouped)
+}
+
+// Group calls f with a copy of the default SimpleLogger which keeps all entries logged inside f contiguous
+func Group(f func(l *SimpleLogger)) {
+	Default().Group(f)
+}
@@ -0,0 +1,42 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestGroupFlushNoDeadlock(t *testing.T) {
+	l := New(0)
+	l.SetOutput(&bytes.Buffer{})
+	l.SetBuffered(4096)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				_ = l.Flush()
+			}
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 10000; i++ {
+			l.Group(func(l *SimpleLogger) {
+				l.Info("first")
+				l.Info("second")
+			})
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("Group deadlocked with a concurrent Flush")
+	}
+}
@@ -0,0 +1,103 @@
+// Package grpclogger adapts a log.SimpleLogger to the grpclog.LoggerV2 interface without depending on gRPC
+package grpclogger
+
+import (
+	"fmt"
+
+	"github.com/disgoorg/log"
+)
+
+// New returns a Logger which logs to the given SimpleLogger.
+// It can be passed to grpclog.SetLoggerV2
+func New(l *log.SimpleLogger) *Logger {
+	return &Logger{logger: l}
+}
+
+// Logger implements the grpclog.LoggerV2 interface on top of a SimpleLogger.
+// V(0) is mapped to LevelInfo, V(1) to LevelDebug and everything above to LevelTrace
+type Logger struct {
+	logger *log.SimpleLogger
+}
+
+// Info logs on the LevelInfo
+func (g *Logger) Info(args ...any) {
+	g.logger.Output(3, log.LevelInfo, args...)
+}
+
+// Infoln logs on the LevelInfo
+func (g *Logger) Infoln(args ...any) {
+	g.logger.Output(3, log.LevelInfo, sprintln(args...))
+}
+
+// Infof logs on the LevelInfo
+func (g *Logger) Infof(format string, args ...any) {
+	g.logger.Outputf(3, log.LevelInfo, format, args...)
+}
+
+// Warning logs on the LevelWarn
+func (g *Logger) Warning(args ...any) {
+	g.logger.Output(3, log.LevelWarn, args...)
+}
+
+// Warningln logs on the LevelWarn
+func (g *Logger) Warningln(args ...any) {
+	g.logger.Output(3, log.LevelWarn, sprintln(args...))
+}
+
+// Warningf logs on the LevelWarn
+func (g *Logger) Warningf(format string, args ...any) {
+	g.logger.Outputf(3, log.LevelWarn, format, args...)
+}
+
+// Error logs on the LevelError
+func (g *Logger) Error(args ...any) {
+	g.logger.Output(3, log.LevelError, args...)
+}
+
+// Errorln logs on the LevelError
+func (g *Logger) Errorln(args ...any) {
+	g.logger.Output(3, log.LevelError, sprintln(args...))
+}
+
+// Errorf logs on the LevelError
+func (g *Logger) Errorf(format string, args ...any) {
+	g.logger.Outputf(3, log.LevelError, format, args...)
+}
+
+// Fatal logs on the LevelFatal
+func (g *Logger) Fatal(args ...any) {
+	g.logger.Output(3, log.LevelFatal, args...)
+}
+
+// Fatalln logs on the LevelFatal
+func (g *Logger) Fatalln(args ...any) {
+	g.logger.Output(3, log.LevelFatal, sprintln(args...))
+}
+
+// Fatalf logs on the LevelFatal
+func (g *Logger) Fatalf(format string, args ...any) {
+	g.logger.Outputf(3, log.LevelFatal, format, args...)
+}
+
+// V reports whether the given grpc verbosity is enabled
+func (g *Logger) V(l int) bool {
+	return g.logger.Enabled(verbosityLevel(l))
+}
+
+// verbosityLevel maps a grpc verbosity to a log.Level
+func verbosityLevel(level int) log.Level {
+	switch level {
+	case 0:
+		return log.LevelInfo
+	case 1:
+		return log.LevelDebug
+	default:
+		return log.LevelTrace
+	}
+}
+
+// sprintln formats like fmt.Sprintln without the trailing newline
+func sprintln(args ...any) string {
+	s := fmt.Sprintln(args...)
+	return s[:len(s)-1]
+}
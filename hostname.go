@@ -0,0 +1,47 @@
+package log
+
+import (
+	"os"
+	"sync"
+)
+
+var (
+	hostnameOnce   sync.Once
+	cachedHostname string
+)
+
+// hostname returns the hostname reported by os.Hostname. It is only looked up once
+func hostname() string {
+	hostnameOnce.Do(func() {
+		cachedHostname, _ = os.Hostname()
+	})
+	return cachedHostname
+}
+
+// SetHostname adds the hostname reported by os.Hostname as "host" default field to each entry.
+// The hostname is looked up once and cached
+func (l *SimpleLogger) SetHostname() {
+	l.SetHostnameValue(hostname())
+}
+
+// SetHostnameValue adds the given hostname as "host" default field to each entry
+func (l *SimpleLogger) SetHostnameValue(name string) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	defaultFields := make(Fields, len(l.defaultFields)+1)
+	for key, value := range l.defaultFields {
+		defaultFields[key] = value
+	}
+	defaultFields["host"] = name
+	l.defaultFields = defaultFields
+}
+
+// SetHostname adds the hostname reported by os.Hostname as "host" default field to each entry of the default Logger
+func SetHostname() {
+	Default().SetHostname()
+}
+
+// SetHostnameValue adds the given hostname as "host" default field to each entry of the default Logger
+func SetHostnameValue(name string) {
+	Default().SetHostnameValue(name)
+}
@@ -0,0 +1,201 @@
+package log
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+var _ Formatter = (*JSONFormatter)(nil)
+
+// machineFormatter is used by SetHumanReadable if the output is no terminal
+var machineFormatter = &JSONFormatter{}
+
+// JSONFormatter renders entries as JSON objects, one per line.
+// Unless Pretty is set the output is valid NDJSON: each entry ends with exactly one newline
+// and newlines in messages and field values are escaped by the JSON encoder.
+// Fields of a group created with WithGroup are rendered as nested objects.
+// The caller is rendered as separate file and line fields so it can be queried like line=42
+type JSONFormatter struct {
+	// TimeKey is the key of the time field. Defaults to "time"
+	TimeKey string
+	// LevelKey is the key of the level field. Defaults to "level"
+	LevelKey string
+	// SeverityCodeKey is the key of the numeric severity field if Lseveritycode is set. Defaults to "severity_code"
+	SeverityCodeKey string
+	// MessageKey is the key of the message field. Defaults to "msg"
+	MessageKey string
+	// CallerKey is the key of the caller field if CombinedCaller is set. Defaults to "caller"
+	CallerKey string
+	// CombinedCaller renders the caller as a single file:line field instead of separate file and line fields
+	CombinedCaller bool
+	// FileKey is the key of the file field. Defaults to "file"
+	FileKey string
+	// LineKey is the key of the line field. Defaults to "line"
+	LineKey string
+	// SequenceKey is the key of the sequence field if Lsequence is set. Defaults to "seq"
+	SequenceKey string
+	// PIDKey is the key of the process ID field if Lpid is set. Defaults to "pid"
+	PIDKey string
+	// TimeFormat is the layout used to render the time field. Defaults to time.RFC3339Nano
+	TimeFormat string
+	// Pretty indents the JSON objects for development. This breaks parsers which expect one entry per line
+	Pretty bool
+}
+
+// Format renders the Entry as a single JSON object followed by a newline
+func (f *JSONFormatter) Format(entry Entry) ([]byte, error) {
+	data := make(map[string]any, len(entry.Fields)+4)
+	for key, value := range entry.Fields {
+		data[key] = jsonValue(entry, value)
+	}
+
+	if entry.Flags&Lnotimestamp == 0 {
+		if entry.EpochUnit != 0 {
+			data[orDefault(f.TimeKey, "time")] = entry.Epoch()
+		} else {
+			data[orDefault(f.TimeKey, "time")] = entry.Time.Format(orDefault(f.TimeFormat, time.RFC3339Nano))
+		}
+	}
+	data[orDefault(f.LevelKey, "level")] = entry.Level.name()
+	if entry.Flags&Lseveritycode != 0 {
+		data[orDefault(f.SeverityCodeKey, "severity_code")] = entry.Level.SeverityCode()
+	}
+	data[orDefault(f.MessageKey, "msg")] = entry.Message
+	if entry.File != "" {
+		if f.CombinedCaller {
+			data[orDefault(f.CallerKey, "caller")] = formatCaller(entry)
+		} else {
+			data[orDefault(f.FileKey, "file")] = callerFile(entry)
+			data[orDefault(f.LineKey, "line")] = entry.Line
+		}
+	}
+	if entry.Flags&Lsequence != 0 {
+		data[orDefault(f.SequenceKey, "seq")] = entry.Sequence
+	}
+	if entry.Flags&Lpid != 0 {
+		data[orDefault(f.PIDKey, "pid")] = pid
+	}
+
+	buf, err := marshalJSON(data, entry.FieldOrder)
+	if err != nil {
+		// replace only the fields which can't be marshaled instead of dropping the whole entry
+		replaceUnserializable(data)
+		if buf, err = marshalJSON(data, entry.FieldOrder); err != nil {
+			return nil, err
+		}
+	}
+	if f.Pretty {
+		var indented bytes.Buffer
+		if err = json.Indent(&indented, buf, "", "  "); err != nil {
+			return nil, err
+		}
+		buf = indented.Bytes()
+	}
+	return append(buf, '\n'), nil
+}
+
+// marshalJSON marshals data with its keys sorted or in the given insertion order
+func marshalJSON(data map[string]any, order []string) ([]byte, error) {
+	if order == nil {
+		return json.Marshal(data)
+	}
+	return appendOrderedJSON(nil, data, "", order)
+}
+
+// appendOrderedJSON appends data as JSON object with its keys in the order of orderedKeys to buf
+func appendOrderedJSON(buf []byte, data map[string]any, prefix string, order []string) ([]byte, error) {
+	buf = append(buf, '{')
+	for i, key := range orderedKeys(data, prefix, order) {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		keyJSON, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, keyJSON...)
+		buf = append(buf, ':')
+
+		if group, ok := data[key].(map[string]any); ok {
+			if buf, err = appendOrderedJSON(buf, group, prefix+key+".", order); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		valueJSON, err := json.Marshal(data[key])
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, valueJSON...)
+	}
+	return append(buf, '}'), nil
+}
+
+// jsonValue converts values which have no useful JSON representation.
+// Values implementing json.Marshaler or encoding.TextMarshaler keep their own representation.
+// Other values are rendered with the ValueFormatter of the Entry if it returns something else than DefaultValue
+func jsonValue(entry Entry, value any) any {
+	switch v := value.(type) {
+	case Fields:
+		fields := make(map[string]any, len(v))
+		for key, value := range v {
+			fields[key] = jsonValue(entry, value)
+		}
+		return fields
+	case json.Marshaler, encoding.TextMarshaler:
+		return value
+	}
+	if entry.ValueFormatter != nil {
+		if s := entry.ValueFormatter(value); s != DefaultValue {
+			return entry.truncateValue(s)
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return entry.truncateValue(v)
+	case []byte:
+		return entry.formatBytes(v)
+	case error:
+		return entry.truncateValue(v.Error())
+	default:
+		return value
+	}
+}
+
+// replaceUnserializable replaces all values which can't be marshaled to JSON with a "<unserializable: type>" placeholder.
+// Nested groups are checked field by field
+func replaceUnserializable(data map[string]any) {
+	for key, value := range data {
+		if group, ok := value.(map[string]any); ok {
+			replaceUnserializable(group)
+			continue
+		}
+		if _, err := json.Marshal(value); err != nil {
+			data[key] = fmt.Sprintf("<unserializable: %T>", value)
+		}
+	}
+}
+
+// formatCaller renders the caller of the Entry as file:line while respecting Lshortfile
+func formatCaller(entry Entry) string {
+	return callerFile(entry) + ":" + strconv.Itoa(entry.Line)
+}
+
+// callerFile returns the file of the Entry while respecting Lshortfile
+func callerFile(entry Entry) string {
+	if entry.Flags&Lshortfile != 0 {
+		return shortFile(entry.File)
+	}
+	return entry.File
+}
+
+func orDefault(s string, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
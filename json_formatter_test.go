@@ -0,0 +1,112 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestJSONFormatterUnserializableField(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := New(Lnotimestamp)
+	l.SetOutput(buf)
+	l.SetFormatter(&JSONFormatter{})
+
+	l.WithField("ch", make(chan int)).WithField("user", "alice").Info("message")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("expected valid JSON, got %q: %s", buf.String(), err)
+	}
+	if entry["ch"] != "<unserializable: chan int>" {
+		t.Errorf("expected the channel to be replaced, got %v", entry["ch"])
+	}
+	if entry["user"] != "alice" || entry["msg"] != "message" {
+		t.Errorf("expected the rest of the entry, got %v", entry)
+	}
+}
+
+func TestJSONFormatterNDJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := New(Lnotimestamp)
+	l.SetOutput(buf)
+	l.SetFormatter(&JSONFormatter{})
+
+	messages := []string{"first", "multi\nline", "third"}
+	for _, msg := range messages {
+		l.WithField("value", "with\nnewline").Info(msg)
+	}
+	if lines := bytes.Count(buf.Bytes(), []byte("\n")); lines != len(messages) {
+		t.Errorf("expected one line per entry, got %d lines: %q", lines, buf.String())
+	}
+
+	decoder := json.NewDecoder(buf)
+	for _, msg := range messages {
+		var entry map[string]any
+		if err := decoder.Decode(&entry); err != nil {
+			t.Fatalf("failed to decode entry %q: %s", msg, err)
+		}
+		if entry["msg"] != msg || entry["value"] != "with\nnewline" {
+			t.Errorf("unexpected entry: %v", entry)
+		}
+	}
+	if decoder.More() {
+		t.Error("expected no more entries")
+	}
+}
+
+func TestFlagTogglesSurviveSetFlags(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := New(0)
+	l.SetOutput(buf)
+	l.SetFormatter(&JSONFormatter{})
+	l.SetFlagsForLevel(LevelError, Lshortfile)
+	l.SetReportTimestamp(false)
+	l.SetShowPID(true)
+	l.SetShowSequence(true)
+	l.SetFlags(LstdFlags)
+
+	for _, level := range []Level{LevelInfo, LevelError} {
+		buf.Reset()
+		l.Output(2, level, "message")
+
+		var entry map[string]any
+		if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+			t.Fatalf("expected valid JSON, got %q: %s", buf.String(), err)
+		}
+		if _, ok := entry["time"]; ok {
+			t.Errorf("%s: expected no time field, got %v", level.name(), entry)
+		}
+		if _, ok := entry["pid"]; !ok {
+			t.Errorf("%s: expected a pid field, got %v", level.name(), entry)
+		}
+		if _, ok := entry["seq"]; !ok {
+			t.Errorf("%s: expected a seq field, got %v", level.name(), entry)
+		}
+	}
+}
+
+func TestStructuredFormattersSplitCaller(t *testing.T) {
+	tests := []struct {
+		formatter Formatter
+		want      []string
+	}{
+		{formatter: &JSONFormatter{}, want: []string{`"file":"json_formatter_test.go"`, `"line":`}},
+		{formatter: &JSONFormatter{CombinedCaller: true}, want: []string{`"caller":"json_formatter_test.go:`}},
+		{formatter: &LogfmtFormatter{}, want: []string{"file=json_formatter_test.go", "line="}},
+		{formatter: &LogfmtFormatter{CombinedCaller: true}, want: []string{"caller=json_formatter_test.go:"}},
+	}
+	for _, tt := range tests {
+		buf := &bytes.Buffer{}
+		l := New(Lshortfile)
+		l.SetOutput(buf)
+		l.SetFormatter(tt.formatter)
+		l.Info("message")
+		for _, want := range tt.want {
+			if !strings.Contains(buf.String(), want) {
+				t.Errorf("%T: expected %s in %q", tt.formatter, want, buf.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package log
+
+import (
+	"fmt"
+)
+
+// LazyValue is a field value which is only computed if an entry is actually logged.
+// It is called at most once per entry and its result is passed to observers and the Formatter.
+// If it panics the panic is reported to the handler registered with OnWriteError or os.Stderr and rendered as value like "!PANIC(boom)"
+type LazyValue func() any
+
+// resolve calls the LazyValue and recovers from a panic of it which is passed to report
+func (v LazyValue) resolve(report func(err error)) (value any) {
+	defer func() {
+		if r := recover(); r != nil {
+			report(fmt.Errorf("log: lazy field panicked: %v", r))
+			value = fmt.Sprintf("!PANIC(%v)", r)
+		}
+	}()
+	return v()
+}
+
+// WithLazyField returns a copy of the SimpleLogger which attaches the result of fn with the given key to each entry.
+// fn is only called for entries which pass the Level filter which avoids computing expensive values for disabled Level(s).
+// The copy shares its output with the SimpleLogger it was created from
+func (l *SimpleLogger) WithLazyField(key string, fn func() any) *SimpleLogger {
+	return l.WithField(key, LazyValue(fn))
+}
+
+// resolveLazyFields returns fields with all LazyValue(s) including those of groups replaced by their result.
+// fields is copied before the first replacement so shared Fields are never modified. Panics of LazyValue(s) are passed to report
+func resolveLazyFields(fields Fields, report func(err error)) Fields {
+	resolved, _ := resolveLazy(fields, report)
+	return resolved
+}
+
+// resolveLazy replaces all LazyValue(s) of fields and reports whether fields was copied
+func resolveLazy(fields Fields, report func(err error)) (Fields, bool) {
+	resolved := fields
+	copied := false
+	for key, value := range fields {
+		var newValue any
+		switch v := value.(type) {
+		case LazyValue:
+			newValue = v.resolve(report)
+		case Fields:
+			group, ok := resolveLazy(v, report)
+			if !ok {
+				continue
+			}
+			newValue = group
+		default:
+			continue
+		}
+		if !copied {
+			resolved = make(Fields, len(fields))
+			for k, v := range fields {
+				resolved[k] = v
+			}
+			copied = true
+		}
+		resolved[key] = newValue
+	}
+	return resolved, copied
+}
@@ -0,0 +1,141 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+var (
+	_ io.WriteCloser = (*rotatingFile)(nil)
+	_ io.Closer      = (leveledFiles)(nil)
+)
+
+// NewLeveledFiles returns a SimpleLogger which writes the entries of each Level to its own file like debug.log or error.log in dir.
+// All entries are additionally written to all.log. Each file is rotated once it would grow beyond maxSize bytes by renaming it
+// to the same name with a .1 suffix which replaces the previous rotated file. A maxSize of 0 or less disables rotation.
+// The returned io.Closer closes all files and is also called by Close of the SimpleLogger
+func NewLeveledFiles(dir string, maxSize int64) (*SimpleLogger, io.Closer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	all, err := openRotatingFile(filepath.Join(dir, "all.log"), maxSize)
+	if err != nil {
+		return nil, nil, err
+	}
+	files := leveledFiles{all}
+
+	l := New(LstdFlags)
+	l.SetColors(false)
+	l.SetOutput(all)
+	for _, level := range Levels() {
+		file, err := openRotatingFile(filepath.Join(dir, level.name()+".log"), maxSize)
+		if err != nil {
+			_ = files.Close()
+			return nil, nil, err
+		}
+		files = append(files, file)
+		l.SetLevelOutput(level, io.MultiWriter(file, all))
+	}
+
+	l.mu.Lock()
+	l.closer = files
+	l.mu.Unlock()
+	return l, files, nil
+}
+
+// leveledFiles closes all files opened by NewLeveledFiles
+type leveledFiles []*rotatingFile
+
+// Close closes all files and returns the first error
+func (f leveledFiles) Close() error {
+	var firstErr error
+	for _, file := range f {
+		if err := file.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// openRotatingFile opens or creates the file at path for appending
+func openRotatingFile(path string, maxSize int64) (*rotatingFile, error) {
+	f := &rotatingFile{path: path, maxSize: maxSize}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// rotatingFile is an io.WriteCloser which appends to a file and rotates it once it exceeds maxSize bytes
+type rotatingFile struct {
+	mu      sync.Mutex
+	path    string
+	maxSize int64
+	size    int64
+	file    *os.File
+}
+
+// open opens the file at path for appending and records its current size
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open log file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+// rotate renames the current file to the .1 backup and opens a new empty file
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file: %w", err)
+	}
+	f.file = nil
+	if err := os.Rename(f.path, f.path+".1"); err != nil {
+		// keep appending to the current file
+		if openErr := f.open(); openErr != nil {
+			return openErr
+		}
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+	return f.open()
+}
+
+// Write appends p to the file and rotates it first if p would grow it beyond maxSize
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return 0, os.ErrClosed
+	}
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Close closes the file. Closing it again is a no-op
+func (f *rotatingFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
@@ -0,0 +1,65 @@
+package log
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+var _ Formatter = (*LogfmtFormatter)(nil)
+
+// LogfmtFormatter renders entries as logfmt key=value pairs, one entry per line.
+// Fields of a group created with WithGroup are prefixed with the group name like group.key.
+// The caller is rendered as separate file and line fields so it can be queried like line=42
+type LogfmtFormatter struct {
+	// TimeFormat is the layout used to render the time field. Defaults to time.RFC3339Nano
+	TimeFormat string
+	// CombinedCaller renders the caller as a single caller=file:line field instead of separate file and line fields
+	CombinedCaller bool
+}
+
+// Format renders the Entry as a single logfmt line
+func (f *LogfmtFormatter) Format(entry Entry) ([]byte, error) {
+
+	var b strings.Builder
+	if entry.Flags&Lnotimestamp == 0 {
+		if entry.EpochUnit != 0 {
+			writeLogfmtPair(&b, "time", strconv.FormatInt(entry.Epoch(), 10))
+		} else {
+			writeLogfmtPair(&b, "time", entry.Time.Format(orDefault(f.TimeFormat, time.RFC3339Nano)))
+		}
+	}
+	writeLogfmtPair(&b, "level", entry.Level.name())
+	writeLogfmtPair(&b, "msg", entry.Message)
+	if entry.File != "" {
+		if f.CombinedCaller {
+			writeLogfmtPair(&b, "caller", formatCaller(entry))
+		} else {
+			writeLogfmtPair(&b, "file", callerFile(entry))
+			writeLogfmtPair(&b, "line", strconv.Itoa(entry.Line))
+		}
+	}
+	if entry.Flags&Lsequence != 0 {
+		writeLogfmtPair(&b, "seq", strconv.FormatUint(entry.Sequence, 10))
+	}
+	if entry.Flags&Lpid != 0 {
+		writeLogfmtPair(&b, "pid", strconv.Itoa(pid))
+	}
+	entry.walkFields(func(key string, value any) {
+		writeLogfmtPair(&b, key, entry.formatValue(value))
+	})
+	b.WriteByte('\n')
+	return []byte(b.String()), nil
+}
+
+func writeLogfmtPair(b *strings.Builder, key string, value string) {
+	if b.Len() > 0 {
+		b.WriteByte(' ')
+	}
+	b.WriteString(key)
+	b.WriteByte('=')
+	if value == "" || strings.ContainsAny(value, " =\"\t\r\n") {
+		value = strconv.Quote(value)
+	}
+	b.WriteString(value)
+}
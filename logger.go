@@ -1,20 +1,9 @@
 package log
 
-// Logger is the logging interface you can implement/use
-type Logger interface {
-	Trace(args ...any)
-	Debug(args ...any)
-	Info(args ...any)
-	Warn(args ...any)
-	Error(args ...any)
-	Fatal(args ...any)
-	Panic(args ...any)
+import (
+	"github.com/disgoorg/log/logger"
+)
 
-	Tracef(format string, args ...any)
-	Debugf(format string, args ...any)
-	Infof(format string, args ...any)
-	Warnf(format string, args ...any)
-	Errorf(format string, args ...any)
-	Fatalf(format string, args ...any)
-	Panicf(format string, args ...any)
-}
+// Logger is the logging interface you can implement/use.
+// It is an alias of logger.Logger which can be imported without depending on this package
+type Logger = logger.Logger
@@ -0,0 +1,22 @@
+// Package logger contains the Logger interface without any dependencies.
+// Libraries which only accept a Logger can import this package instead of github.com/disgoorg/log
+package logger
+
+// Logger is the logging interface you can implement/use
+type Logger interface {
+	Trace(args ...any)
+	Debug(args ...any)
+	Info(args ...any)
+	Warn(args ...any)
+	Error(args ...any)
+	Fatal(args ...any)
+	Panic(args ...any)
+
+	Tracef(format string, args ...any)
+	Debugf(format string, args ...any)
+	Infof(format string, args ...any)
+	Warnf(format string, args ...any)
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+	Panicf(format string, args ...any)
+}
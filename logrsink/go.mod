@@ -0,0 +1,13 @@
+module github.com/disgoorg/log/logrsink
+
+go 1.18
+
+require (
+	github.com/disgoorg/log v0.0.0-20261014141014-6afb62c54ad9
+	github.com/go-logr/logr v1.4.2
+)
+
+require (
+	golang.org/x/sys v0.5.0 // indirect
+	golang.org/x/term v0.5.0 // indirect
+)
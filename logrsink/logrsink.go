@@ -0,0 +1,72 @@
+// Package logrsink adapts a log.SimpleLogger to the logr.LogSink interface.
+// It lives in its own module so github.com/disgoorg/log doesn't depend on github.com/go-logr/logr
+package logrsink
+
+import (
+	"github.com/go-logr/logr"
+
+	"github.com/disgoorg/log"
+)
+
+var (
+	_ logr.LogSink          = (*logrSink)(nil)
+	_ logr.CallDepthLogSink = (*logrSink)(nil)
+)
+
+// New returns a logr.LogSink which logs to the given SimpleLogger.
+// V(0) is mapped to LevelInfo, V(1) to LevelDebug and everything above to LevelTrace.
+// Names are passed to SimpleLogger.WithName
+func New(l *log.SimpleLogger) logr.LogSink {
+	return &logrSink{logger: l}
+}
+
+type logrSink struct {
+	logger    *log.SimpleLogger
+	calldepth int
+}
+
+func (s *logrSink) Init(info logr.RuntimeInfo) {
+	s.calldepth += info.CallDepth
+}
+
+func (s *logrSink) Enabled(level int) bool {
+	return s.logger.Enabled(logrLevel(level))
+}
+
+func (s *logrSink) Info(level int, msg string, keysAndValues ...any) {
+	s.logger.With(keysAndValues...).Output(s.calldepth+3, logrLevel(level), msg)
+}
+
+func (s *logrSink) Error(err error, msg string, keysAndValues ...any) {
+	s.logger.With(keysAndValues...).WithField("error", err).Output(s.calldepth+3, log.LevelError, msg)
+}
+
+func (s *logrSink) WithValues(keysAndValues ...any) logr.LogSink {
+	clone := *s
+	clone.logger = s.logger.With(keysAndValues...)
+	return &clone
+}
+
+func (s *logrSink) WithName(name string) logr.LogSink {
+	clone := *s
+	clone.logger = s.logger.WithName(name)
+	return &clone
+}
+
+func (s *logrSink) WithCallDepth(depth int) logr.LogSink {
+	clone := *s
+	clone.calldepth += depth
+	return &clone
+}
+
+// logrLevel maps a logr verbosity to a log.Level
+func logrLevel(level int) log.Level {
+	switch level {
+	case 0:
+		return log.LevelInfo
+	case 1:
+		return log.LevelDebug
+	default:
+		return log.LevelTrace
+	}
+}
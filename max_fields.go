@@ -0,0 +1,28 @@
+package log
+
+// SetMaxFields sets the maximum number of Fields rendered per entry. The limit applies to the merged default, attached and per entry Fields.
+// Fields beyond the limit are dropped in the order they are rendered and a "fields_truncated" field set to true is added.
+// Fields of a group count as one field. A limit <= 0 disables it which is the default
+func (l *SimpleLogger) SetMaxFields(n int) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.maxFields = n
+}
+
+// limitFields returns a copy of fields with only the first n fields in the rendered order and the "fields_truncated" marker
+func limitFields(fields Fields, order []string, n int) (Fields, []string) {
+	limited := make(Fields, n+1)
+	for _, key := range orderedKeys(fields, "", order)[:n] {
+		limited[key] = fields[key]
+	}
+	limited["fields_truncated"] = true
+	if order != nil {
+		order = append(order[:len(order):len(order)], "fields_truncated")
+	}
+	return limited, order
+}
+
+// SetMaxFields sets the maximum number of Fields rendered per entry of the default Logger
+func SetMaxFields(n int) {
+	Default().SetMaxFields(n)
+}
@@ -0,0 +1,50 @@
+package log
+
+import (
+	"sync/atomic"
+)
+
+// MaxLevelSeen returns the highest Level of all entries logged by the SimpleLogger and its copies since it was created or ResetMaxLevelSeen was called.
+// If nothing was logged LevelTrace is returned
+func (l *SimpleLogger) MaxLevelSeen() Level {
+	if maxLevel := atomic.LoadInt32(&l.maxLevel); maxLevel > 0 {
+		return Level(maxLevel - 1)
+	}
+	return LevelTrace
+}
+
+// HadErrors reports whether any entry on LevelError or above was logged since the SimpleLogger was created or ResetMaxLevelSeen was called.
+// Test runners and CI tools can use this to fail if errors were logged
+func (l *SimpleLogger) HadErrors() bool {
+	return atomic.LoadInt32(&l.maxLevel) > int32(LevelError)
+}
+
+// ResetMaxLevelSeen resets the highest Level returned by MaxLevelSeen
+func (l *SimpleLogger) ResetMaxLevelSeen() {
+	atomic.StoreInt32(&l.maxLevel, 0)
+}
+
+// updateMaxLevel raises the highest Level seen to level
+func (l *SimpleLogger) updateMaxLevel(level Level) {
+	for {
+		current := atomic.LoadInt32(&l.maxLevel)
+		if int32(level)+1 <= current || atomic.CompareAndSwapInt32(&l.maxLevel, current, int32(level)+1) {
+			return
+		}
+	}
+}
+
+// MaxLevelSeen returns the highest Level of all entries logged by the default Logger
+func MaxLevelSeen() Level {
+	return Default().MaxLevelSeen()
+}
+
+// HadErrors reports whether any entry on LevelError or above was logged by the default Logger
+func HadErrors() bool {
+	return Default().HadErrors()
+}
+
+// ResetMaxLevelSeen resets the highest Level returned by MaxLevelSeen of the default Logger
+func ResetMaxLevelSeen() {
+	Default().ResetMaxLevelSeen()
+}
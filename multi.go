@@ -0,0 +1,123 @@
+package log
+
+import (
+	"fmt"
+	"os"
+)
+
+// NewMulti creates a new Logger which passes each call to all given Logger(s).
+// Each Logger keeps its own configuration like Level, Formatter and output.
+// Fatal and Panic are passed to all Logger(s) before the program exits or panics once.
+// Logger(s) which aren't a *SimpleLogger receive Fatal entries as Error so they don't exit before the others got the entry
+func NewMulti(loggers ...Logger) Logger {
+	return &multiLogger{loggers: loggers}
+}
+
+type multiLogger struct {
+	loggers []Logger
+}
+
+// output passes msg to all Logger(s) and exits or panics afterwards for LevelFatal and LevelPanic
+func (m *multiLogger) output(level Level, msg string) {
+	for _, l := range m.loggers {
+		outputTo(l, level, msg)
+	}
+	switch level {
+	case LevelFatal:
+		os.Exit(1)
+	case LevelPanic:
+		panic(msg)
+	}
+}
+
+// outputTo passes msg to l without exiting or panicking
+func outputTo(l Logger, level Level, msg string) {
+	if level == LevelPanic {
+		defer func() {
+			_ = recover()
+		}()
+	}
+	if simpleLogger, ok := l.(*SimpleLogger); ok {
+		if level == LevelFatal {
+			simpleLogger = simpleLogger.clone()
+			simpleLogger.fatalNoExit = true
+		}
+		simpleLogger.Output(5, level, msg)
+		return
+	}
+
+	switch level {
+	case LevelTrace:
+		l.Trace(msg)
+	case LevelDebug:
+		l.Debug(msg)
+	case LevelInfo:
+		l.Info(msg)
+	case LevelWarn:
+		l.Warn(msg)
+	case LevelError:
+		l.Error(msg)
+	case LevelFatal:
+		// Fatal of other Logger(s) usually exits immediately so the following Logger(s) wouldn't get the entry.
+		// The multiLogger exits once after all Logger(s) got it instead
+		l.Error(msg)
+	case LevelPanic:
+		l.Panic(msg)
+	}
+}
+
+func (m *multiLogger) Trace(args ...any) {
+	m.output(LevelTrace, fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Debug(args ...any) {
+	m.output(LevelDebug, fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Info(args ...any) {
+	m.output(LevelInfo, fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Warn(args ...any) {
+	m.output(LevelWarn, fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Error(args ...any) {
+	m.output(LevelError, fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Fatal(args ...any) {
+	m.output(LevelFatal, fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Panic(args ...any) {
+	m.output(LevelPanic, fmt.Sprint(args...))
+}
+
+func (m *multiLogger) Tracef(format string, args ...any) {
+	m.output(LevelTrace, fmt.Sprintf(format, args...))
+}
+
+func (m *multiLogger) Debugf(format string, args ...any) {
+	m.output(LevelDebug, fmt.Sprintf(format, args...))
+}
+
+func (m *multiLogger) Infof(format string, args ...any) {
+	m.output(LevelInfo, fmt.Sprintf(format, args...))
+}
+
+func (m *multiLogger) Warnf(format string, args ...any) {
+	m.output(LevelWarn, fmt.Sprintf(format, args...))
+}
+
+func (m *multiLogger) Errorf(format string, args ...any) {
+	m.output(LevelError, fmt.Sprintf(format, args...))
+}
+
+func (m *multiLogger) Fatalf(format string, args ...any) {
+	m.output(LevelFatal, fmt.Sprintf(format, args...))
+}
+
+func (m *multiLogger) Panicf(format string, args ...any) {
+	m.output(LevelPanic, fmt.Sprintf(format, args...))
+}
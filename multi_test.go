@@ -0,0 +1,39 @@
+package log
+
+import (
+	"bytes"
+	"testing"
+)
+
+// recordingLogger is a Logger which records the Error and Fatal calls
+type recordingLogger struct {
+	Logger
+	errors []string
+	fatals []string
+}
+
+func (l *recordingLogger) Error(args ...any) {
+	l.errors = append(l.errors, args[0].(string))
+}
+
+func (l *recordingLogger) Fatal(args ...any) {
+	l.fatals = append(l.fatals, args[0].(string))
+}
+
+func TestMultiFatalReachesAllLoggers(t *testing.T) {
+	first := &recordingLogger{Logger: NewNoop()}
+	buf := &bytes.Buffer{}
+	second := New(0)
+	second.SetColors(false)
+	second.SetOutput(buf)
+
+	for _, l := range []Logger{first, second} {
+		outputTo(l, LevelFatal, "fatal")
+	}
+	if len(first.fatals) != 0 || len(first.errors) != 1 {
+		t.Errorf("expected Fatal to be passed as Error to other Logger(s), got errors %v and fatals %v", first.errors, first.fatals)
+	}
+	if got := buf.String(); got != "FATAL fatal\n" {
+		t.Errorf("expected the SimpleLogger to get the entry without exiting, got %q", got)
+	}
+}
@@ -0,0 +1,40 @@
+package log
+
+import (
+	"fmt"
+)
+
+// OnEntry registers an observer which is called with each entry emitted by the SimpleLogger or any of its copies.
+// Observers are called synchronously in the order they were registered before the entry is written.
+// A panicking observer is reported to the handler registered with OnWriteError or os.Stderr and doesn't stop the entry from being written
+func (l *SimpleLogger) OnEntry(observer func(entry Entry)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.observers = append(l.observers[:len(l.observers):len(l.observers)], observer)
+}
+
+func (l *SimpleLogger) notifyObservers(entry Entry) {
+	l.mu.Lock()
+	observers := l.observers
+	l.mu.Unlock()
+
+	for _, observer := range observers {
+		notifyObserver(observer, entry, l.reportError)
+	}
+}
+
+// notifyObserver calls observer with entry and recovers from a panic of it which is passed to report
+func notifyObserver(observer func(entry Entry), entry Entry, report func(err error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			report(fmt.Errorf("log: observer panicked: %v", r))
+		}
+	}()
+	observer(entry)
+}
+
+// OnEntry registers an observer which is called with each entry emitted through the package level functions
+// or any copy of the default SimpleLogger
+func OnEntry(observer func(entry Entry)) {
+	Default().OnEntry(observer)
+}
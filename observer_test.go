@@ -0,0 +1,54 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type panickingFormatter struct{}
+
+func (panickingFormatter) Format(Entry) ([]byte, error) {
+	panic("formatter")
+}
+
+func TestPanickingHooksDontStopLogging(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := New(0)
+	l.SetColors(false)
+	l.SetOutput(buf)
+	l.OnEntry(func(Entry) {
+		panic("observer")
+	})
+	l.AddMessageFilter(func(string) string {
+		panic("filter")
+	})
+	l.SetLevelFormatter(LevelWarn, panickingFormatter{})
+	var errs []string
+	l.OnWriteError(func(err error) {
+		errs = append(errs, err.Error())
+	})
+
+	l.WithLazyField("lazy", func() any {
+		panic("lazy")
+	}).Info("first")
+	l.Warn("dropped by the formatter")
+	l.Info("second")
+
+	want := "INFO  first lazy=!PANIC(lazy)\nINFO  second\n"
+	if got := buf.String(); got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	for _, hook := range []string{"observer", "filter", "lazy", "formatter"} {
+		found := false
+		for _, err := range errs {
+			if strings.Contains(err, "panicked: "+hook) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected the handler to receive the panic of the %s, got %q", hook, errs)
+		}
+	}
+}
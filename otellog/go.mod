@@ -0,0 +1,14 @@
+module github.com/disgoorg/log/otellog
+
+go 1.18
+
+require (
+	github.com/disgoorg/log v0.0.0-20261014141014-6afb62c54ad9
+	go.opentelemetry.io/otel/trace v1.14.0
+)
+
+require (
+	go.opentelemetry.io/otel v1.14.0 // indirect
+	golang.org/x/sys v0.5.0 // indirect
+	golang.org/x/term v0.5.0 // indirect
+)
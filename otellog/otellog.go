@@ -0,0 +1,25 @@
+// Package otellog attaches OpenTelemetry trace context to entries of a log.SimpleLogger.
+// It lives in its own module so github.com/disgoorg/log doesn't depend on OpenTelemetry
+package otellog
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/disgoorg/log"
+)
+
+// WithTraceContext returns a copy of the SimpleLogger which attaches the "trace_id" and "span_id" fields of the
+// OpenTelemetry span in ctx to each entry so entries can be correlated with traces. Pass log.Default() to use the default SimpleLogger.
+// If ctx holds no valid span the SimpleLogger itself is returned
+func WithTraceContext(l *log.SimpleLogger, ctx context.Context) *log.SimpleLogger {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return l
+	}
+	return l.WithFields(log.Fields{
+		"trace_id": spanContext.TraceID().String(),
+		"span_id":  spanContext.SpanID().String(),
+	})
+}
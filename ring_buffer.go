@@ -0,0 +1,80 @@
+package log
+
+import (
+	"io"
+	"sync"
+)
+
+var (
+	_ io.Writer   = (*RingBuffer)(nil)
+	_ io.WriterTo = (*RingBuffer)(nil)
+)
+
+// NewRingBuffer returns a RingBuffer which keeps the last size entries written to it
+func NewRingBuffer(size int) *RingBuffer {
+	return &RingBuffer{
+		entries: make([][]byte, size),
+	}
+}
+
+// RingBuffer is an in memory io.Writer which keeps the most recent entries.
+// Use it as output (or part of an io.MultiWriter) to capture the log tail
+type RingBuffer struct {
+	mu      sync.Mutex
+	entries [][]byte
+	next    int
+	full    bool
+}
+
+// Write stores p as one entry and overwrites the oldest entry if the RingBuffer is full
+func (b *RingBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.entries) == 0 {
+		return len(p), nil
+	}
+
+	entry := make([]byte, len(p))
+	copy(entry, p)
+	b.entries[b.next] = entry
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+	return len(p), nil
+}
+
+// Len returns the number of entries stored in the RingBuffer
+func (b *RingBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.full {
+		return len(b.entries)
+	}
+	return b.next
+}
+
+// WriteTo writes all stored entries from oldest to newest to w.
+// Entries written to the RingBuffer while WriteTo is running are not included
+func (b *RingBuffer) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, entry := range b.snapshot() {
+		written, err := w.Write(entry)
+		n += int64(written)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+func (b *RingBuffer) snapshot() [][]byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.full {
+		return append([][]byte(nil), b.entries[:b.next]...)
+	}
+	entries := make([][]byte, 0, len(b.entries))
+	entries = append(entries, b.entries[b.next:]...)
+	return append(entries, b.entries[:b.next]...)
+}
@@ -0,0 +1,45 @@
+package log
+
+import (
+	"runtime"
+	"strings"
+)
+
+// SetSelfDebug sets whether the SimpleLogger logs an entry on LevelTrace with the new value each time it is configured
+// with SetLevel, SetLevelVar, SetFlags, SetFlagsForLevel, SetColors, SetOutput, SetLevelOutput, SetFormatter,
+// SetLevelFormatter, SetFormatFunc, SetAsync or SetBuffered. This helps finding where a SimpleLogger is misconfigured.
+// The entries are logged regardless of the Level of the SimpleLogger
+func (l *SimpleLogger) SetSelfDebug(enabled bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.selfDebug = enabled
+}
+
+// debugSetting logs the new value of the setting if self debugging is enabled
+func (l *SimpleLogger) debugSetting(setting string, value any) {
+	l.configMu.RLock()
+	selfDebug := l.selfDebug
+	l.configMu.RUnlock()
+	if !selfDebug {
+		return
+	}
+	// skip package level functions and setters calling other setters to report the caller outside this package
+	calldepth := 2
+	for {
+		pc, _, _, ok := runtime.Caller(calldepth)
+		if !ok {
+			break
+		}
+		fn := runtime.FuncForPC(pc)
+		if fn == nil || !strings.HasPrefix(fn.Name(), "github.com/disgoorg/log.") {
+			break
+		}
+		calldepth++
+	}
+	l.log(calldepth+1, l.now(), LevelTrace, "log: "+setting+" called", Fields{"setting": setting, "value": value})
+}
+
+// SetSelfDebug sets whether the default Logger logs an entry on LevelTrace each time it is configured
+func SetSelfDebug(enabled bool) {
+	Default().SetSelfDebug(enabled)
+}
@@ -1,14 +1,24 @@
 package log
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
-	"log"
+	"io"
 	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+	"unsafe"
 )
 
 var _ Logger = (*SimpleLogger)(nil)
 
-var std = New(log.LstdFlags)
+var std = New(LstdFlags)
 
 // These flags define which text to prefix to each Output entry generated by the Logger.
 // Bits are or'ed together to control what's printed.
@@ -24,6 +34,11 @@ var std = New(log.LstdFlags)
 // while flags Ldate | Ltime | Lmicroseconds | Llongfile produce,
 //
 //	2009/01/23 01:23:23.123123 /a/b/c/d.go:23: message
+//
+// The TextFormatter always renders the level label immediately before the message,
+// so flags Ldate | Ltime | Lshortfile | Lmsgprefix produce,
+//
+//	2009/01/23 01:23:23 d.go:23: INFO  message
 const (
 	Ldate         = 1 << iota     // the date in the local time zone: 2009/01/23
 	Ltime                         // the time in the local time zone: 01:23:23
@@ -31,20 +46,39 @@ const (
 	Llongfile                     // full file name and line number: /a/b/c/d.go:23
 	Lshortfile                    // final file name element and line number: d.go:23. overrides Llongfile
 	LUTC                          // if Ldate or Ltime is set, use UTC rather than the local time zone
-	Lmsgprefix                    // move the "prefix" from the beginning of the line to before the message
+	Lmsgprefix                    // move the "prefix" from the beginning of the line to before the level and message
+	Lshortlevel                   // single letter level labels: I instead of INFO
+	Lsequence                     // monotonically increasing sequence number of the entry: 42
+	Lnanoseconds                  // nanosecond resolution: 01:23:23.123123123. assumes Ltime, overrides Lmicroseconds
+	Lnolevel                      // omit the level label of the TextFormatter: message
+	Lnotimestamp                  // omit the time field of the JSONFormatter and LogfmtFormatter
+	Lcompacttime                  // if Ldate is set, only render the date on the first entry and when the day changes
+	Lseveritycode                 // add the numeric SeverityCode of the Level to the JSONFormatter: "severity_code":200
+	Lpid                          // the process ID of the program: [1234]
 	LstdFlags     = Ldate | Ltime // initial values for the standard logger
 )
 
+// timeFlags are all flags which render the time
+const timeFlags = Ldate | Ltime | Lmicroseconds | Lnanoseconds
+
+// pid is the process ID rendered with Lpid. It never changes so it is only looked up once
+var pid = os.Getpid()
+
 // Level are different levels at which the SimpleLogger can Output
 type Level int
 
-// All Level(s) which SimpleLogger supports
+// All Level(s) which SimpleLogger supports in ascending order.
+// The numeric values are not stable: adding LevelDPanic changed LevelFatal from 5 to 6 and LevelPanic from 6 to 7.
+// Store and compare Level(s) by their name or use SeverityCode for a stable numeric severity.
+// UnmarshalJSON rejects the ambiguous numeric values 5 and 6
 const (
 	LevelTrace Level = iota
 	LevelDebug
 	LevelInfo
 	LevelWarn
 	LevelError
+	// LevelDPanic panics in development and is logged on LevelError otherwise, see SetDevelopment
+	LevelDPanic
 	LevelFatal
 	LevelPanic
 )
@@ -62,6 +96,8 @@ func (l Level) String() string {
 		return "WARN "
 	case LevelError:
 		return "ERROR"
+	case LevelDPanic:
+		return "DPANC"
 	case LevelFatal:
 		return "FATAL"
 	case LevelPanic:
@@ -71,6 +107,201 @@ func (l Level) String() string {
 	}
 }
 
+// name returns the lowercase name of the Level without padding
+func (l Level) name() string {
+	if l == LevelDPanic {
+		// String is shortened to fit the five character labels
+		return "dpanic"
+	}
+	return strings.ToLower(strings.TrimSpace(l.String()))
+}
+
+// shortString returns the single letter label of the Level rendered with Lshortlevel.
+// LevelDPanic uses A for assertion as D is already used by LevelDebug
+func (l Level) shortString() string {
+	if l == LevelDPanic {
+		return "A"
+	}
+	if s := l.String(); s != "" {
+		return s[:1]
+	}
+	return ""
+}
+
+// MetricLabel returns a stable lowercase label of the Level like "info" for metrics.
+// Unlike String it never changes with the display format. Unknown Level(s) return "unknown"
+func (l Level) MetricLabel() string {
+	switch l {
+	case LevelTrace:
+		return "trace"
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	case LevelDPanic:
+		return "dpanic"
+	case LevelFatal:
+		return "fatal"
+	case LevelPanic:
+		return "panic"
+	default:
+		return "unknown"
+	}
+}
+
+// SeverityCode returns a stable numeric severity of the Level following the LogSeverity values of Google Cloud Logging.
+// The codes are trace 50, debug 100, info 200, warn 400, error 500, dpanic 600, fatal 700 and panic 800.
+// Unknown Level(s) return 0. Unlike the Level itself the codes never change when Level(s) are added
+func (l Level) SeverityCode() int {
+	switch l {
+	case LevelTrace:
+		return 50
+	case LevelDebug:
+		return 100
+	case LevelInfo:
+		return 200
+	case LevelWarn:
+		return 400
+	case LevelError:
+		return 500
+	case LevelDPanic:
+		return 600
+	case LevelFatal:
+		return 700
+	case LevelPanic:
+		return 800
+	default:
+		return 0
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler and returns the lowercase name of the Level like "info"
+func (l Level) MarshalText() ([]byte, error) {
+	name := l.name()
+	if name == "" {
+		return nil, fmt.Errorf("unknown level: %d", l)
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler and parses the Level with ParseLevel
+func (l *Level) UnmarshalText(text []byte) error {
+	level, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*l = level
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler and returns the lowercase name of the Level as JSON string like "info"
+func (l Level) MarshalJSON() ([]byte, error) {
+	text, err := l.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler and parses the Level from a JSON string like "info".
+// For compatibility the numeric value of a Level is accepted as well. The numeric values 5 and 6 are rejected because they
+// meant LevelFatal and LevelPanic before LevelDPanic was added and LevelDPanic and LevelFatal afterwards, see the Level constants
+func (l *Level) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var level int
+		if err = json.Unmarshal(data, &level); err != nil {
+			return fmt.Errorf("level must be a string or number: %w", err)
+		}
+		switch Level(level) {
+		case LevelDPanic, LevelFatal:
+			return fmt.Errorf("ambiguous numeric level: %d, use the level name instead", level)
+		case LevelTrace, LevelDebug, LevelInfo, LevelWarn, LevelError, LevelPanic:
+			*l = Level(level)
+			return nil
+		default:
+			return fmt.Errorf("unknown level: %d", level)
+		}
+	}
+	return l.UnmarshalText([]byte(name))
+}
+
+// Enabled reports whether the Level is logged by a SimpleLogger with the given minimum Level
+func (l Level) Enabled(min Level) bool {
+	return l >= min
+}
+
+// IsTerminal reports whether logging on the Level ends the program flow like LevelFatal and LevelPanic
+func (l Level) IsTerminal() bool {
+	return l == LevelFatal || l == LevelPanic
+}
+
+// NewLevelVar returns a LevelVar holding the given Level
+func NewLevelVar(level Level) *LevelVar {
+	v := &LevelVar{}
+	v.Store(level)
+	return v
+}
+
+// LevelVar is a Level which can be changed and read concurrently without locking
+type LevelVar struct {
+	level int32
+}
+
+// Load returns the Level
+func (v *LevelVar) Load() Level {
+	return Level(atomic.LoadInt32(&v.level))
+}
+
+// Store sets the Level
+func (v *LevelVar) Store(level Level) {
+	atomic.StoreInt32(&v.level, int32(level))
+}
+
+// Levels returns all Level(s) which SimpleLogger supports in ascending order
+func Levels() []Level {
+	return []Level{LevelTrace, LevelDebug, LevelInfo, LevelWarn, LevelError, LevelDPanic, LevelFatal, LevelPanic}
+}
+
+// LevelNames returns the lowercase names of all Level(s) in ascending order like "trace".
+// All names are accepted by ParseLevel
+func LevelNames() []string {
+	levels := Levels()
+	names := make([]string, len(levels))
+	for i, level := range levels {
+		names[i] = level.name()
+	}
+	return names
+}
+
+// ParseLevel parses a Level from its case-insensitive name like "info" or "WARN"
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "TRACE":
+		return LevelTrace, nil
+	case "DEBUG":
+		return LevelDebug, nil
+	case "INFO":
+		return LevelInfo, nil
+	case "WARN":
+		return LevelWarn, nil
+	case "ERROR":
+		return LevelError, nil
+	case "DPANIC":
+		return LevelDPanic, nil
+	case "FATAL":
+		return LevelFatal, nil
+	case "PANIC":
+		return LevelPanic, nil
+	default:
+		return 0, fmt.Errorf("unknown level: %q", s)
+	}
+}
+
 var (
 	EnableColors = true
 	PrefixStyle  = ForegroundColorBrightBlack
@@ -79,13 +310,14 @@ var (
 )
 
 var Styles = map[Level]Style{
-	LevelTrace: ForegroundColorBrightBlack,
-	LevelDebug: ForegroundColorWhite,
-	LevelInfo:  ForegroundColorCyan,
-	LevelWarn:  ForegroundColorYellow,
-	LevelError: ForegroundColorBrightRed,
-	LevelFatal: ForegroundColorRed,
-	LevelPanic: ForegroundColorMagenta,
+	LevelTrace:  ForegroundColorBrightBlack,
+	LevelDebug:  ForegroundColorWhite,
+	LevelInfo:   ForegroundColorCyan,
+	LevelWarn:   ForegroundColorYellow,
+	LevelError:  ForegroundColorBrightRed,
+	LevelDPanic: ForegroundColorMagenta,
+	LevelFatal:  ForegroundColorRed,
+	LevelPanic:  ForegroundColorMagenta,
 }
 
 // SetLevelColor sets the Style of the given Level
@@ -93,100 +325,840 @@ func SetLevelColor(level Level, color Style) {
 	Styles[level] = color
 }
 
-// Default returns the default SimpleLogger
+// Default returns the default SimpleLogger used by all package level functions
 func Default() *SimpleLogger {
 	return std
 }
 
-// SetDefault sets the default SimpleLogger
+// SetDefault sets the default SimpleLogger used by all package level functions.
+// The default is deliberately typed as *SimpleLogger instead of Logger because package level
+// configuration functions like SetLevel, SetFlags or SetOutput need to configure it.
+// Pass other Logger implementations to your libraries directly instead
 func SetDefault(logger *SimpleLogger) {
 	std = logger
 }
 
+// Reset replaces the default SimpleLogger with a new one in its initial state logging on LevelInfo with LstdFlags to os.Stderr.
+// The previous default SimpleLogger is closed first which writes pending entries, stops its async goroutine and closes its output.
+// It is primarily meant for tests so configuration changes of one test don't leak into others
+func Reset() {
+	_ = std.Close()
+	std = New(LstdFlags)
+}
+
 // New returns a newInt SimpleLogger implementation
 func New(flags int) *SimpleLogger {
 	return &SimpleLogger{
-		logger: log.New(os.Stderr, "", flags),
-		level:  LevelInfo,
+		output:    &output{w: os.Stderr, terminal: isTerminal(os.Stderr), flushLevel: LevelWarn},
+		flags:     flags,
+		level:     unsafe.Pointer(NewLevelVar(LevelInfo)),
+		exitLevel: LevelError,
+		formatter: &TextFormatter{},
+		colors:    true,
 	}
 }
 
-// SimpleLogger is a wrapper for the std Logger
+// SimpleLogger is a level aware Logger which renders entries with a Formatter.
+// Its configuration is guarded by configMu of the output so it can be changed while logging concurrently
 type SimpleLogger struct {
-	logger *log.Logger
-	level  Level
-	prefix Style
+	*output
+	flags      int
+	levelFlags map[Level]int
+	// flagsOn and flagsOff are the flags toggled with setters like SetShowPID which apply on top of flags and levelFlags
+	flagsOn    int
+	flagsOff   int
+	bareLevels map[Level]bool
+	// level is the *LevelVar. It is accessed atomically so Enabled doesn't need to acquire configMu
+	level unsafe.Pointer
+	// scopedLevel is set by AtLevel so the scoped Level takes precedence over component Level(s)
+	scopedLevel    bool
+	colors         bool
+	autoColors     bool
+	strictFormat   bool
+	messageFilters []func(string) string
+	maxMessageLen  int
+	maxFields      int
+	fatalNoExit    bool
+	development    bool
+	exitOnError    bool
+	exitLevel      Level
+	humanReadable  bool
+	selfDebug      bool
+	alignFields    bool
+	// grouped is set for the SimpleLogger passed to the Group callback which already holds writeMu
+	grouped         bool
+	formatter       Formatter
+	levelFormatters map[Level]Formatter
+	formatFunc      FormatFunc
+	callerTrim      string
+	location        *time.Location
+	clock           Clock
+	epochUnit       time.Duration
+	valueFormatter  func(value any) string
+	levelFormat     func(level Level) string
+	bytesEncoding   BytesEncoding
+
+	defaultFields Fields
+	fields        Fields
+	fieldOrder    FieldOrder
+	fieldKeys     []string
+	groups        []string
+	name          string
+
+	// err is the error attached with WithError
+	err             error
+	stacktraceLevel *Level
 }
 
-// SetLevel sets the lowest Level to Output for
+// output is shared between a SimpleLogger and all copies created from it.
+// If both writeMu and mu are needed writeMu must be acquired first, see lockOutput. mu must never be held while acquiring writeMu
+type output struct {
+	// dropped, suppressed, sequence and lastDate are accessed atomically and need to be 64-bit aligned
+	dropped    uint64
+	suppressed uint64
+	sequence   uint64
+	// lastDate is the date of the last entry rendered with Lcompacttime as yyyymmdd
+	lastDate int64
+	// maxLevel is the highest Level logged plus one so 0 means nothing was logged. It is accessed atomically
+	maxLevel int32
+
+	// configMu guards the configuration fields of the SimpleLogger and all copies sharing the output.
+	// It is never held while acquiring other locks or calling user code
+	configMu sync.RWMutex
+
+	// mu guards the fields below
+	mu             sync.Mutex
+	w              io.Writer
+	levels         map[Level]io.Writer
+	async          *asyncQueue
+	overflowPolicy OverflowPolicy
+	buffer         *bufio.Writer
+	flushLevel     Level
+	closer         io.Closer
+	// terminal reports whether w is a terminal
+	terminal  bool
+	observers []func(Entry)
+	// locker is an external lock held around each write
+	locker      sync.Locker
+	errorStream io.Writer
+	errorLevel  Level
+	// writeTimeout bounds each write if it is greater than 0. writing is held by the write in progress
+	writeTimeout time.Duration
+	writing      chan struct{}
+	onWriteError func(err error)
+
+	// writeMu serializes writes to the underlying writers
+	writeMu sync.Mutex
+}
+
+// write writes p to the output of the Level. If locked is true the caller holds writeMu and p is written directly
+func (o *output) write(level Level, p []byte, locked bool) {
+	o.mu.Lock()
+	var (
+		w      io.Writer
+		buffer *bufio.Writer
+	)
+	if levelWriter, ok := o.levels[level]; ok {
+		w = levelWriter
+	} else if o.buffer != nil {
+		w = o.buffer
+		if level.Enabled(o.flushLevel) {
+			buffer = o.buffer
+		}
+	} else {
+		w = o.w
+	}
+	pw := pendingWrite{w: w, p: p, buffer: buffer, locker: o.locker, onError: o.onWriteError}
+	if o.writeTimeout > 0 {
+		pw.timeout = o.writeTimeout
+		pw.out = o
+	}
+	if o.errorStream != nil && level.Enabled(o.errorLevel) {
+		pw.errorStream = o.errorStream
+	}
+	async := o.async
+	policy := o.overflowPolicy
+	o.mu.Unlock()
+
+	if locked {
+		writeLocked(pw)
+		return
+	}
+	if async != nil {
+		async.send(asyncEntry{pendingWrite: pw}, policy)
+		return
+	}
+	o.writeTo(pw)
+}
+
+// pendingWrite is a single entry which is written to w
+type pendingWrite struct {
+	w io.Writer
+	p []byte
+	// buffer is flushed after the write if it is not nil
+	buffer *bufio.Writer
+	// errorStream receives a copy of the entry if it is not nil
+	errorStream io.Writer
+	// locker is held during the write if it is not nil
+	locker sync.Locker
+	// timeout bounds the write of out if it is greater than 0
+	timeout time.Duration
+	out     *output
+	// onError is called with write errors if it is not nil
+	onError func(err error)
+}
+
+// writeTo writes the pendingWrite
+func (o *output) writeTo(pw pendingWrite) {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+	writeLocked(pw)
+}
+
+// writeLocked writes the pendingWrite. The caller must hold writeMu.
+// Each entry is passed to the underlying io.Writer with a single Write call so lines are never split
+func writeLocked(pw pendingWrite) {
+	if pw.timeout > 0 {
+		timeout := pw.timeout
+		pw.timeout = 0
+		pw.out.writeWithTimeout(pw, timeout)
+		return
+	}
+	if pw.locker != nil {
+		pw.locker.Lock()
+		defer pw.locker.Unlock()
+	}
+	if bw, ok := pw.w.(*bufio.Writer); ok && len(pw.p) > bw.Available() && bw.Buffered() > 0 {
+		// flush first so bufio.Writer doesn't split the entry over two writes
+		notifyWriteError(pw.onError, bw.Flush())
+	}
+	_, err := pw.w.Write(pw.p)
+	notifyWriteError(pw.onError, err)
+	if pw.buffer != nil {
+		notifyWriteError(pw.onError, pw.buffer.Flush())
+	}
+	if pw.errorStream != nil {
+		_, err = pw.errorStream.Write(pw.p)
+		notifyWriteError(pw.onError, err)
+	}
+}
+
+// SetLevel sets the lowest Level to Output for. Copies created with WithField and similar share the Level
 func (l *SimpleLogger) SetLevel(level Level) {
-	l.level = level
+	defer l.debugSetting("SetLevel", level.name())
+	l.levelVar().Store(level)
+}
+
+// Enabled reports whether entries on the given Level are logged.
+// Named SimpleLogger(s) use the Level set with SetComponentLevels for their name if there is one
+// unless the Level was scoped with AtLevel
+func (l *SimpleLogger) Enabled(level Level) bool {
+	if l.name != "" && !l.scopedLevel {
+		if min, ok := componentLevel(l.name); ok {
+			return level.Enabled(min)
+		}
+	}
+	return level.Enabled(l.levelVar().Load())
+}
+
+// levelVar returns the LevelVar of the SimpleLogger
+func (l *SimpleLogger) levelVar() *LevelVar {
+	return (*LevelVar)(atomic.LoadPointer(&l.level))
+}
+
+// LevelVar returns the LevelVar which holds the lowest Level to Output for
+func (l *SimpleLogger) LevelVar() *LevelVar {
+	return l.levelVar()
+}
+
+// SetLevelVar replaces the LevelVar which holds the lowest Level to Output for.
+// Share one LevelVar between multiple SimpleLogger(s) to change their Level at once
+func (l *SimpleLogger) SetLevelVar(levelVar *LevelVar) {
+	defer l.debugSetting("SetLevelVar", levelVar.Load().name())
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	atomic.StorePointer(&l.level, unsafe.Pointer(levelVar))
+}
+
+// clone returns a copy of the SimpleLogger which shares its output. It is safe to call while the SimpleLogger is configured concurrently
+func (l *SimpleLogger) clone() *SimpleLogger {
+	l.configMu.RLock()
+	defer l.configMu.RUnlock()
+	clone := *l
+	return &clone
+}
+
+// AtLevel calls f with a copy of the SimpleLogger which has its own LevelVar set to the given Level.
+// This allows raising or lowering the verbosity around a single operation without affecting concurrent logging.
+// The scoped Level takes precedence over the Level set with SetComponentLevels for the name of the SimpleLogger.
+// The copy shares its output and Formatter with the SimpleLogger it was created from
+func (l *SimpleLogger) AtLevel(level Level, f func(l *SimpleLogger)) {
+	scoped := l.clone()
+	scoped.level = unsafe.Pointer(NewLevelVar(level))
+	scoped.scopedLevel = true
+	f(scoped)
 }
 
 // SetFlags sets the Output flags like: Ldate, Ltime, Lmicroseconds, Llongfile, Lshortfile, LUTC, Lmsgprefix,LstdFlags
 func (l *SimpleLogger) SetFlags(flags int) {
-	l.logger.SetFlags(flags)
+	defer l.debugSetting("SetFlags", flags)
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.flags = flags
 }
 
-func (l *SimpleLogger) Output(calldepth int, level Level, v ...any) {
-	if level < l.level {
-		return
+// SetFlagsForLevel sets the Output flags used for entries of the given Level instead of the flags set with SetFlags.
+// Flags toggled with setters like SetShowPID or SetReportTimestamp still apply on top of them
+func (l *SimpleLogger) SetFlagsForLevel(level Level, flags int) {
+	defer l.debugSetting("SetFlagsForLevel", Fields{"level": level.name(), "flags": flags})
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	levelFlags := make(map[Level]int, len(l.levelFlags)+1)
+	for lvl, f := range l.levelFlags {
+		levelFlags[lvl] = f
 	}
+	levelFlags[level] = flags
+	l.levelFlags = levelFlags
+}
 
-	if l.prefix != PrefixStyle {
-		l.prefix = PrefixStyle
-		l.logger.SetPrefix(PrefixStyle.String())
+// toggleFlag forces flag on or off for all entries regardless of the flags set with SetFlags and SetFlagsForLevel.
+// The caller must hold configMu
+func (l *SimpleLogger) toggleFlag(flag int, on bool) {
+	if on {
+		l.flagsOn |= flag
+		l.flagsOff &^= flag
+	} else {
+		l.flagsOff |= flag
+		l.flagsOn &^= flag
 	}
+}
 
-	v = append(v, "", StyleReset)
-	copy(v[2:], v)
+// SetBareLevel sets whether entries of the given Level are rendered without a level label like Lnolevel does.
+// This allows using LevelInfo for plain user facing output while warnings and errors are still labeled
+func (l *SimpleLogger) SetBareLevel(level Level, bare bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	bareLevels := make(map[Level]bool, len(l.bareLevels)+1)
+	for lvl, b := range l.bareLevels {
+		bareLevels[lvl] = b
+	}
+	bareLevels[level] = bare
+	l.bareLevels = bareLevels
+}
+
+// SetColors sets whether entries are rendered with Style(s). Colors are only rendered if EnableColors is true as well
+func (l *SimpleLogger) SetColors(enabled bool) {
+	defer l.debugSetting("SetColors", enabled)
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.colors = enabled
+}
+
+// SetAutoColors sets whether colors are only rendered if the output is a terminal.
+// The terminal detection is updated on each SetOutput so redirecting the output to a file or buffer disables colors.
+// The NO_COLOR and FORCE_COLOR environment variables take precedence over the detection, see SetColorMode
+func (l *SimpleLogger) SetAutoColors(auto bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.autoColors = auto
+}
 
-	levelStr := level.String() + " "
-	textStyleStr := ""
-	endStyleStr := ""
-	if EnableColors {
-		levelStr = LevelStyle.And(Styles[level]).Apply(levelStr)
-		textStyleStr = TextStyle.String()
-		endStyleStr = StyleReset.String()
+// colorsEnabled reports whether entries should be rendered with Style(s)
+func (l *SimpleLogger) colorsEnabled() bool {
+	if !EnableColors || !l.colors {
+		return false
 	}
-	v[0] = levelStr
-	v[1] = textStyleStr
+	if !l.autoColors {
+		return true
+	}
+	if noColor {
+		return false
+	}
+	return forceColor || l.isTerminal()
+}
 
-	s := fmt.Sprint(v...) + endStyleStr
-	switch level {
-	case LevelFatal:
-		_ = l.logger.Output(calldepth, s)
-		os.Exit(1)
-	case LevelPanic:
-		_ = l.logger.Output(calldepth, s)
-		panic(s)
-	default:
-		_ = l.logger.Output(calldepth, s)
+// SetStrictFormat sets whether format errors like %!d(string=foo) in formatted messages are reported with an additional entry on LevelWarn.
+// The additional entry contains the caller and format string of the bad call
+func (l *SimpleLogger) SetStrictFormat(strict bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.strictFormat = strict
+}
+
+// SetHumanReadable sets whether the SimpleLogger automatically switches between the human readable Formatter on terminals
+// and JSON for machines if the output is no terminal. The terminal detection is updated on each SetOutput
+func (l *SimpleLogger) SetHumanReadable(auto bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.humanReadable = auto
+}
+
+func (l *SimpleLogger) isTerminal() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.terminal
+}
+
+// SetAlignFields sets whether the TextFormatter aligns fields in a column and wraps them based on the terminal width.
+// Entries written to outputs which are no terminal stay on a single line
+func (l *SimpleLogger) SetAlignFields(align bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.alignFields = align
+}
+
+// terminalWidth returns the width of the terminal of the output or 0 if it is no terminal
+func (l *SimpleLogger) terminalWidth() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.terminal {
+		return 0
+	}
+	return terminalWidth(l.w)
+}
+
+// SetFatalNoExit sets whether entries on LevelFatal skip calling os.Exit and return normally.
+// This is useful in tests which want to assert on the output of Fatal
+func (l *SimpleLogger) SetFatalNoExit(noExit bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.fatalNoExit = noExit
+}
+
+// SetDevelopment sets whether the SimpleLogger is in development mode in which entries on LevelDPanic panic after they are written like on LevelPanic.
+// Outside of development mode they are logged on LevelError instead. This catches programmer errors early without crashing in production
+func (l *SimpleLogger) SetDevelopment(development bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.development = development
+}
+
+// SetMaxMessageLength sets the maximum length of messages and rendered field values in bytes. Longer ones are truncated and marked with "…(truncated)".
+// The limit is applied after message filters. A length <= 0 disables truncation which is the default
+func (l *SimpleLogger) SetMaxMessageLength(n int) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.maxMessageLen = n
+}
+
+// truncateMessage cuts msg to n bytes without splitting a UTF-8 encoded rune
+func truncateMessage(msg string, n int) string {
+	for n > 0 && !utf8.RuneStart(msg[n]) {
+		n--
+	}
+	return msg[:n] + "…(truncated)"
+}
+
+// SetExitOnError sets whether entries on the exit Level and above exit the program after they are written like entries on LevelFatal.
+// The exit Level defaults to LevelError and can be changed with SetExitLevel. SetFatalNoExit applies as well
+func (l *SimpleLogger) SetExitOnError(exit bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.exitOnError = exit
+}
+
+// SetExitLevel sets the Level at and above which entries exit the program if SetExitOnError is enabled
+func (l *SimpleLogger) SetExitLevel(level Level) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.exitLevel = level
+}
+
+// AddMessageFilter adds a filter which can rewrite the message of each entry before it is formatted.
+// Filters run in the order they were added. A panicking filter is reported to os.Stderr and skipped
+func (l *SimpleLogger) AddMessageFilter(filter func(msg string) string) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.messageFilters = append(l.messageFilters[:len(l.messageFilters):len(l.messageFilters)], filter)
+}
+
+// SetBareLevel sets whether entries of the given Level of the default Logger are rendered without a level label
+func SetBareLevel(level Level, bare bool) {
+	Default().SetBareLevel(level, bare)
+}
+
+// SetShortLevels toggles the Lshortlevel flag which renders single letter level labels like D, I, W, E, A, F and P
+func (l *SimpleLogger) SetShortLevels(short bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.toggleFlag(Lshortlevel, short)
+}
+
+// SetReportTimestamp toggles the Lnotimestamp flag which omits the time field of structured output like JSON and logfmt.
+// This is useful if the entries are already timestamped by the collector like journald or Docker. Timestamps are reported by default
+func (l *SimpleLogger) SetReportTimestamp(report bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.toggleFlag(Lnotimestamp, !report)
+}
+
+// SetShowPID toggles the Lpid flag which prefixes each entry with the process ID or adds it as pid field to structured output like JSON and logfmt.
+// This disambiguates interleaved entries of multiple processes writing to the same log
+func (l *SimpleLogger) SetShowPID(show bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.toggleFlag(Lpid, show)
+}
+
+// SetEmitNumericSeverity toggles the Lseveritycode flag which adds the numeric SeverityCode of the Level to entries rendered with the JSONFormatter
+// alongside the level name. This helps backends which sort or filter on a numeric severity
+func (l *SimpleLogger) SetEmitNumericSeverity(emit bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.toggleFlag(Lseveritycode, emit)
+}
+
+// SetCompactTime toggles the Lcompacttime flag which only renders the date on the first entry and when the day changes.
+// Entries of the same day only show the time which keeps console output short
+func (l *SimpleLogger) SetCompactTime(compact bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.toggleFlag(Lcompacttime, compact)
+}
+
+// SetShowSequence toggles the Lsequence flag which prefixes each entry with a monotonically increasing sequence number.
+// The sequence is shared with all copies of the SimpleLogger
+func (l *SimpleLogger) SetShowSequence(show bool) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.toggleFlag(Lsequence, show)
+}
+
+// SetCallerTrimPrefix sets a prefix which is trimmed from the file path of the caller when Llongfile is set.
+// This can be used to print paths relative to the module root instead of the absolute path of the build machine
+func (l *SimpleLogger) SetCallerTrimPrefix(prefix string) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.callerTrim = prefix
+}
+
+// SetTimeZone sets the time.Location timestamps are rendered in. It takes precedence over LUTC.
+// Passing nil restores rendering the local time zone or UTC if LUTC is set
+func (l *SimpleLogger) SetTimeZone(location *time.Location) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.location = location
+}
+
+// SetEpochTimestamps makes the Formatter(s) render timestamps as Unix epoch in the given unit like time.Second, time.Millisecond or time.Nanosecond.
+// Passing 0 restores rendering timestamps as date and time
+func (l *SimpleLogger) SetEpochTimestamps(unit time.Duration) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.epochUnit = unit
+}
+
+// SetFormatter sets the Formatter used to render entries
+func (l *SimpleLogger) SetFormatter(formatter Formatter) {
+	defer l.debugSetting("SetFormatter", fmt.Sprintf("%T", formatter))
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.formatter = formatter
+}
+
+// SetValueFormatter sets the function used by the TextFormatter and LogfmtFormatter to render field values.
+// The JSONFormatter uses it as well for values which don't implement json.Marshaler or encoding.TextMarshaler.
+// If the function is nil or returns DefaultValue the value is rendered with fmt.Sprint
+func (l *SimpleLogger) SetValueFormatter(formatter func(value any) string) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.valueFormatter = formatter
+}
+
+// SetLevelFormat sets the function used by the TextFormatter to render the level decoration like "[INFO] " or "INFO|".
+// The returned string is rendered as is including the separator to the message and replaces the padded Level name and Lshortlevel.
+// Lnolevel still omits the level. If the function is nil the Level name followed by a space is rendered
+func (l *SimpleLogger) SetLevelFormat(format func(level Level) string) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.levelFormat = format
+}
+
+// SetBytesEncoding sets the BytesEncoding []byte field values are rendered in. Defaults to BytesEncodingHex.
+// Rendered values longer than the limit set with SetMaxMessageLength are truncated
+func (l *SimpleLogger) SetBytesEncoding(encoding BytesEncoding) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.bytesEncoding = encoding
+}
+
+// SetFormatFunc sets a FormatFunc used to render entries. It takes precedence over all Formatter(s).
+// Passing nil restores using the Formatter(s)
+func (l *SimpleLogger) SetFormatFunc(formatFunc FormatFunc) {
+	defer l.debugSetting("SetFormatFunc", formatFunc != nil)
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.formatFunc = formatFunc
+}
+
+// SetLevelFormatter sets the Formatter used to render entries of the given Level instead of the default Formatter
+func (l *SimpleLogger) SetLevelFormatter(level Level, formatter Formatter) {
+	defer l.debugSetting("SetLevelFormatter", Fields{"level": level.name(), "formatter": fmt.Sprintf("%T", formatter)})
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	formatters := make(map[Level]Formatter, len(l.levelFormatters)+1)
+	for lvl, f := range l.levelFormatters {
+		formatters[lvl] = f
+	}
+	formatters[level] = formatter
+	l.levelFormatters = formatters
+}
+
+// SetOutput sets the io.Writer all Level(s) without their own output are written to.
+// If the SimpleLogger is buffered the new io.Writer is buffered as well. A nil io.Writer discards all entries
+func (l *SimpleLogger) SetOutput(w io.Writer) {
+	defer l.debugSetting("SetOutput", fmt.Sprintf("%T", w))
+	if w == nil {
+		w = io.Discard
+	}
+	l.flushAsync()
+	l.lockOutput()
+	defer l.unlockOutput()
+	if l.buffer != nil {
+		_ = l.flushBuffer()
+		l.buffer.Reset(w)
+	}
+	l.w = w
+	l.terminal = isTerminal(w)
+}
+
+// SetOutputStdout sets os.Stdout as output and enables SetAutoColors so colors are only rendered if it is a terminal.
+// Colors disabled with SetColors stay disabled
+func (l *SimpleLogger) SetOutputStdout() {
+	l.SetOutput(os.Stdout)
+	l.SetAutoColors(true)
+}
+
+// SetOutputStderr sets os.Stderr as output and enables SetAutoColors so colors are only rendered if it is a terminal.
+// Colors disabled with SetColors stay disabled
+func (l *SimpleLogger) SetOutputStderr() {
+	l.SetOutput(os.Stderr)
+	l.SetAutoColors(true)
+}
+
+// SetLevelOutput sets the io.Writer the given Level is written to instead of the default output.
+// A nil io.Writer discards all entries of the Level
+func (l *SimpleLogger) SetLevelOutput(level Level, w io.Writer) {
+	defer l.debugSetting("SetLevelOutput", Fields{"level": level.name(), "output": fmt.Sprintf("%T", w)})
+	if w == nil {
+		w = io.Discard
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.levels == nil {
+		l.levels = map[Level]io.Writer{}
+	}
+	l.levels[level] = w
+}
+
+// SetOutputLocker sets an external lock which is held around each write to the outputs.
+// This coordinates writes with other components writing to the same destination like a shared file. Pass nil to remove it
+func (l *SimpleLogger) SetOutputLocker(locker sync.Locker) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.locker = locker
+}
+
+// SetErrorStream sets an io.Writer which receives a copy of all entries on the given Level and above in addition to their output.
+// This is useful to log everything to os.Stdout while warnings and errors are also written to os.Stderr. Pass nil to remove it
+func (l *SimpleLogger) SetErrorStream(w io.Writer, minLevel Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.errorStream = w
+	l.errorLevel = minLevel
+}
+
+// UseStdStreams writes LevelInfo and below to os.Stdout and LevelWarn and above to os.Stderr
+func (l *SimpleLogger) UseStdStreams() {
+	for level := LevelTrace; level <= LevelPanic; level++ {
+		if level <= LevelInfo {
+			l.SetLevelOutput(level, os.Stdout)
+		} else {
+			l.SetLevelOutput(level, os.Stderr)
+		}
+	}
+}
+
+// Output logs v on the given Level. The message is built with fmt.Sprint so v is never interpreted as format string.
+// Without any v an entry with an empty message is still emitted.
+// calldepth is the number of stack frames to skip to find the caller which is reported with Llongfile or Lshortfile
+func (l *SimpleLogger) Output(calldepth int, level Level, v ...any) {
+	if !l.Enabled(level) {
+		atomic.AddUint64(&l.suppressed, 1)
+		return
 	}
+	l.log(calldepth, l.now(), level, fmt.Sprint(v...), nil)
+}
+
+// LogAt logs v formatted with fmt.Sprint on the given Level with t as the time of the entry instead of the current time.
+// This is useful to replay or backfill recorded events with their original time
+func (l *SimpleLogger) LogAt(t time.Time, level Level, v ...any) {
+	l.logAt(3, t, level, v...)
 }
 
+// logAt logs v formatted with fmt.Sprint on the given Level at the time t. calldepth is the number of frames to skip to reach the caller
+func (l *SimpleLogger) logAt(calldepth int, t time.Time, level Level, v ...any) {
+	if !l.Enabled(level) {
+		atomic.AddUint64(&l.suppressed, 1)
+		return
+	}
+	l.log(calldepth, t, level, fmt.Sprint(v...), nil)
+}
+
+// Outputf logs v formatted with fmt.Sprintf on the given Level. The formatted message is not formatted again
 func (l *SimpleLogger) Outputf(calldepth int, level Level, format string, v ...any) {
-	l.Output(calldepth+1, level, fmt.Sprintf(format, v...))
+	if !l.Enabled(level) {
+		atomic.AddUint64(&l.suppressed, 1)
+		return
+	}
+	msg := fmt.Sprintf(format, v...)
+	l.configMu.RLock()
+	strict := l.strictFormat
+	l.configMu.RUnlock()
+	if strict && strings.Contains(msg, "%!") && l.Enabled(LevelWarn) {
+		_, file, line, _ := runtime.Caller(calldepth - 1)
+		l.WithFields(Fields{
+			"caller": file + ":" + strconv.Itoa(line),
+			"format": format,
+		}).log(calldepth, l.now(), LevelWarn, "bad format verb in log call", nil)
+	}
+	l.log(calldepth, l.now(), level, msg, nil)
 }
 
-// Trace logs on the LevelTrace
-func (l *SimpleLogger) Trace(v ...any) {
-	l.Output(3, LevelTrace, v...)
+// log renders and writes a single entry at the time t with the additional fields. calldepth is the number of frames to skip to reach the caller
+func (l *SimpleLogger) log(calldepth int, t time.Time, level Level, msg string, fields Fields) {
+	// work on a copy so the configuration can't change while the entry is rendered
+	l = l.clone()
+	if level == LevelDPanic && !l.development {
+		level = LevelError
+	}
+	for _, filter := range l.messageFilters {
+		msg = filterMessage(filter, msg, l.reportError)
+	}
+	if l.maxMessageLen > 0 && len(msg) > l.maxMessageLen {
+		msg = truncateMessage(msg, l.maxMessageLen)
+	}
+
+	flags := l.flags
+	if levelFlags, ok := l.levelFlags[level]; ok {
+		flags = levelFlags
+	}
+	flags = (flags | l.flagsOn) &^ l.flagsOff
+	if l.bareLevels[level] {
+		flags |= Lnolevel
+	}
+
+	if l.location != nil {
+		t = t.In(l.location)
+	} else if flags&LUTC != 0 {
+		t = t.UTC()
+	}
+	if flags&(Lcompacttime|Ldate) == Lcompacttime|Ldate && !l.dateChanged(t) {
+		flags &^= Ldate
+	}
+	entry := Entry{
+		Time:           t,
+		Level:          level,
+		Message:        msg,
+		Fields:         resolveLazyFields(l.entryFields(fields), l.reportError),
+		Flags:          flags,
+		Colors:         l.colorsEnabled(),
+		EpochUnit:      l.epochUnit,
+		ValueFormatter: l.valueFormatter,
+		LevelFormat:    l.levelFormat,
+		BytesEncoding:  l.bytesEncoding,
+		MaxValueLength: l.maxMessageLen,
+	}
+	if l.fieldOrder == FieldOrderInsertion {
+		entry.FieldOrder = l.appendFieldKeys(l.fieldKeys, fields, nil)
+	}
+	if l.maxFields > 0 && len(entry.Fields) > l.maxFields {
+		entry.Fields, entry.FieldOrder = limitFields(entry.Fields, entry.FieldOrder, l.maxFields)
+	}
+	if l.stacktraceLevel != nil && level.Enabled(*l.stacktraceLevel) {
+		entry.Fields = mergeFields(entry.Fields, nil, Fields{"stacktrace": l.stacktrace(calldepth)})
+	}
+	if l.alignFields {
+		entry.Width = l.terminalWidth()
+	}
+	if flags&Lsequence != 0 {
+		entry.Sequence = atomic.AddUint64(&l.sequence, 1)
+	}
+	if flags&(Lshortfile|Llongfile) != 0 {
+		var ok bool
+		if _, entry.File, entry.Line, ok = runtime.Caller(calldepth); !ok {
+			entry.File = "???"
+			entry.Line = 0
+		}
+		entry.File = strings.TrimPrefix(entry.File, l.callerTrim)
+	}
+
+	l.notifyObservers(entry)
+	l.updateMaxLevel(level)
+	p, err := l.format(entry)
+	if err != nil {
+		l.reportError(fmt.Errorf("log: failed to format entry: %w", err))
+	} else {
+		l.write(level, p, l.grouped)
+	}
+
+	if l.exitOnError && level.Enabled(l.exitLevel) && level != LevelDPanic && level != LevelPanic {
+		// exit on the Level like on LevelFatal
+		level = LevelFatal
+	}
+	switch level {
+	case LevelFatal:
+		if l.fatalNoExit {
+			_ = l.Flush()
+			return
+		}
+		if !l.grouped {
+			_ = l.Close()
+		}
+		os.Exit(1)
+	case LevelDPanic, LevelPanic:
+		l.flushAsync()
+		panic(msg)
+	}
 }
 
-// Tracef logs on the LevelTrace
-func (l *SimpleLogger) Tracef(format string, v ...any) {
-	l.Outputf(3, LevelTrace, format, v...)
+// filterMessage applies filter to msg. If filter panics the panic is passed to report and msg is returned unchanged
+func filterMessage(filter func(msg string) string, msg string, report func(err error)) (filtered string) {
+	defer func() {
+		if r := recover(); r != nil {
+			report(fmt.Errorf("log: message filter panicked: %v", r))
+			filtered = msg
+		}
+	}()
+	return filter(msg)
 }
 
-// Debug logs on the LevelDebug
-func (l *SimpleLogger) Debug(v ...any) {
-	l.Output(3, LevelDebug, v...)
+// dateChanged reports whether t is on a different day than the last entry rendered with Lcompacttime and records its date
+func (l *SimpleLogger) dateChanged(t time.Time) bool {
+	year, month, day := t.Date()
+	date := int64(year)*10000 + int64(month)*100 + int64(day)
+	return atomic.SwapInt64(&l.lastDate, date) != date
 }
 
-// Debugf logs on the LevelDebug
-func (l *SimpleLogger) Debugf(format string, v ...any) {
-	l.Outputf(3, LevelDebug, format, v...)
+// format renders the Entry with the FormatFunc, the Formatter of its Level or the default Formatter in this order.
+// In human readable mode the default Formatter is replaced with a JSONFormatter if the output is no terminal.
+// A panic of the Formatter is returned as error so a buggy Formatter can't crash the program
+func (l *SimpleLogger) format(entry Entry) (p []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("formatter panicked: %v", r)
+		}
+	}()
+	if l.formatFunc != nil {
+		return l.formatFunc(entry.Level, entry.Time, entry.Message, entry.Fields), nil
+	}
+	if formatter, ok := l.levelFormatters[entry.Level]; ok {
+		return formatter.Format(entry)
+	}
+	if l.humanReadable && !l.isTerminal() {
+		return machineFormatter.Format(entry)
+	}
+	return l.formatter.Format(entry)
 }
 
 // Info logs on the LevelInfo
@@ -219,6 +1191,24 @@ func (l *SimpleLogger) Errorf(format string, v ...any) {
 	l.Outputf(3, LevelError, format, v...)
 }
 
+// ErrorfReturn logs on the LevelError and returns the error created with fmt.Errorf from the same format and arguments.
+// This allows logging and returning an error in one statement. Errors wrapped with %w can be unwrapped from the returned error
+func (l *SimpleLogger) ErrorfReturn(format string, v ...any) error {
+	err := fmt.Errorf(format, v...)
+	l.Output(3, LevelError, err.Error())
+	return err
+}
+
+// DPanic logs on the LevelDPanic which panics in development and logs on LevelError otherwise
+func (l *SimpleLogger) DPanic(v ...any) {
+	l.Output(3, LevelDPanic, v...)
+}
+
+// DPanicf logs on the LevelDPanic which panics in development and logs on LevelError otherwise
+func (l *SimpleLogger) DPanicf(format string, v ...any) {
+	l.Outputf(3, LevelDPanic, format, v...)
+}
+
 // Fatal logs on the LevelFatal
 func (l *SimpleLogger) Fatal(v ...any) {
 	l.Output(3, LevelFatal, v...)
@@ -239,34 +1229,161 @@ func (l *SimpleLogger) Panicf(format string, v ...any) {
 	l.Outputf(3, LevelPanic, format, v...)
 }
 
-// SetLevel sets the Level of the default Logger
+// SetLevel sets the Level of the default Logger.
+// The Level is stored atomically so it is safe to call concurrently with the package level logging functions
 func SetLevel(level Level) {
 	Default().SetLevel(level)
 }
 
+// AtLevel calls f with a copy of the default SimpleLogger which logs on the given Level and above
+func AtLevel(level Level, f func(l *SimpleLogger)) {
+	Default().AtLevel(level, f)
+}
+
 // SetFlags sets the Output flags like: Ldate, Ltime, Lmicroseconds, Llongfile, Lshortfile, LUTC, Lmsgprefix,LstdFlags of the default Logger
 func SetFlags(flags int) {
 	Default().SetFlags(flags)
 }
 
-// Trace logs on the LevelTrace with the default SimpleLogger
-func Trace(v ...any) {
-	Output(3, LevelTrace, v...)
+// SetColors sets whether entries of the default Logger are rendered with Style(s)
+func SetColors(enabled bool) {
+	Default().SetColors(enabled)
+}
+
+// SetAutoColors sets whether colors of the default Logger are only rendered if the output is a terminal
+func SetAutoColors(auto bool) {
+	Default().SetAutoColors(auto)
+}
+
+// SetStrictFormat sets whether format errors in formatted messages of the default Logger are reported on LevelWarn
+func SetStrictFormat(strict bool) {
+	Default().SetStrictFormat(strict)
+}
+
+// SetExitOnError sets whether entries of the default Logger on the exit Level and above exit the program
+func SetExitOnError(exit bool) {
+	Default().SetExitOnError(exit)
+}
+
+// SetExitLevel sets the Level at and above which entries of the default Logger exit the program if SetExitOnError is enabled
+func SetExitLevel(level Level) {
+	Default().SetExitLevel(level)
+}
+
+// SetMaxMessageLength sets the maximum length of messages in bytes of the default Logger
+func SetMaxMessageLength(n int) {
+	Default().SetMaxMessageLength(n)
+}
+
+// AddMessageFilter adds a filter which can rewrite the message of each entry of the default Logger before it is formatted
+func AddMessageFilter(filter func(msg string) string) {
+	Default().AddMessageFilter(filter)
+}
+
+// SetDefaultFatalNoExit sets whether Fatal and Fatalf of the default Logger skip calling os.Exit and return normally.
+// Libraries using the package-level functions can use this to avoid terminating the host application
+func SetDefaultFatalNoExit(noExit bool) {
+	Default().SetFatalNoExit(noExit)
+}
+
+// SetDevelopment sets whether the default Logger panics on LevelDPanic
+func SetDevelopment(development bool) {
+	Default().SetDevelopment(development)
+}
+
+// SetHumanReadable sets whether the default Logger automatically switches between human readable and JSON output
+func SetHumanReadable(auto bool) {
+	Default().SetHumanReadable(auto)
 }
 
-// Tracef logs on the LevelTrace with the default SimpleLogger
-func Tracef(format string, v ...any) {
-	Outputf(3, LevelTrace, format, v...)
+// SetAlignFields sets whether the fields of the default Logger are aligned based on the terminal width
+func SetAlignFields(align bool) {
+	Default().SetAlignFields(align)
 }
 
-// Debug logs on the LevelDebug with the default SimpleLogger
-func Debug(v ...any) {
-	Output(3, LevelDebug, v...)
+// SetFlagsForLevel sets the Output flags used for entries of the given Level of the default Logger
+func SetFlagsForLevel(level Level, flags int) {
+	Default().SetFlagsForLevel(level, flags)
 }
 
-// Debugf logs on the LevelDebug with the default SimpleLogger
-func Debugf(format string, v ...any) {
-	Outputf(3, LevelDebug, format, v...)
+// SetReportTimestamp toggles the Lnotimestamp flag of the default Logger
+func SetReportTimestamp(report bool) {
+	Default().SetReportTimestamp(report)
+}
+
+// SetShortLevels toggles the Lshortlevel flag of the default Logger
+func SetShortLevels(short bool) {
+	Default().SetShortLevels(short)
+}
+
+// SetShowPID toggles the Lpid flag of the default Logger
+func SetShowPID(show bool) {
+	Default().SetShowPID(show)
+}
+
+// SetEmitNumericSeverity toggles the Lseveritycode flag of the default Logger
+func SetEmitNumericSeverity(emit bool) {
+	Default().SetEmitNumericSeverity(emit)
+}
+
+// SetCompactTime toggles the Lcompacttime flag of the default Logger
+func SetCompactTime(compact bool) {
+	Default().SetCompactTime(compact)
+}
+
+// SetShowSequence toggles the Lsequence flag of the default Logger
+func SetShowSequence(show bool) {
+	Default().SetShowSequence(show)
+}
+
+// SetCallerTrimPrefix sets a prefix which is trimmed from the file path of the caller of the default Logger
+func SetCallerTrimPrefix(prefix string) {
+	Default().SetCallerTrimPrefix(prefix)
+}
+
+// SetTimeZone sets the time.Location timestamps of the default Logger are rendered in
+func SetTimeZone(location *time.Location) {
+	Default().SetTimeZone(location)
+}
+
+// SetEpochTimestamps makes the Formatter(s) of the default Logger render timestamps as Unix epoch in the given unit
+func SetEpochTimestamps(unit time.Duration) {
+	Default().SetEpochTimestamps(unit)
+}
+
+// SetOutput sets the io.Writer of the default Logger
+func SetOutput(w io.Writer) {
+	Default().SetOutput(w)
+}
+
+// SetOutputStdout sets os.Stdout as output of the default Logger and only renders colors if it is a terminal
+func SetOutputStdout() {
+	Default().SetOutputStdout()
+}
+
+// SetOutputStderr sets os.Stderr as output of the default Logger and only renders colors if it is a terminal
+func SetOutputStderr() {
+	Default().SetOutputStderr()
+}
+
+// SetErrorStream sets an io.Writer which receives a copy of all entries on the given Level and above of the default Logger
+func SetErrorStream(w io.Writer, minLevel Level) {
+	Default().SetErrorStream(w, minLevel)
+}
+
+// SetOutputLocker sets an external lock which is held around each write of the default Logger
+func SetOutputLocker(locker sync.Locker) {
+	Default().SetOutputLocker(locker)
+}
+
+// SetLevelOutput sets the io.Writer the given Level is written to of the default Logger
+func SetLevelOutput(level Level, w io.Writer) {
+	Default().SetLevelOutput(level, w)
+}
+
+// UseStdStreams writes LevelInfo and below to os.Stdout and LevelWarn and above to os.Stderr with the default Logger
+func UseStdStreams() {
+	Default().UseStdStreams()
 }
 
 // Info logs on the LevelInfo with the default SimpleLogger
@@ -299,6 +1416,23 @@ func Errorf(format string, v ...any) {
 	Outputf(3, LevelError, format, v...)
 }
 
+// ErrorfReturn logs on the LevelError with the default SimpleLogger and returns the error created with fmt.Errorf
+func ErrorfReturn(format string, v ...any) error {
+	err := fmt.Errorf(format, v...)
+	Output(3, LevelError, err.Error())
+	return err
+}
+
+// DPanic logs on the LevelDPanic with the default SimpleLogger
+func DPanic(v ...any) {
+	Output(3, LevelDPanic, v...)
+}
+
+// DPanicf logs on the LevelDPanic with the default SimpleLogger
+func DPanicf(format string, v ...any) {
+	Outputf(3, LevelDPanic, format, v...)
+}
+
 // Fatal logs on the LevelFatal with the default SimpleLogger
 func Fatal(v ...any) {
 	Output(3, LevelFatal, v...)
@@ -319,10 +1453,17 @@ func Panicf(format string, v ...any) {
 	Outputf(3, LevelPanic, format, v...)
 }
 
+// Output logs v on the given Level with the default SimpleLogger. v is never interpreted as format string
 func Output(calldepth int, level Level, v ...any) {
 	std.Output(calldepth+1, level, v...)
 }
 
+// LogAt logs v formatted with fmt.Sprint on the given Level at the time t with the default SimpleLogger
+func LogAt(t time.Time, level Level, v ...any) {
+	std.logAt(3, t, level, v...)
+}
+
+// Outputf logs v formatted with fmt.Sprintf on the given Level with the default SimpleLogger
 func Outputf(calldepth int, level Level, format string, v ...any) {
 	std.Outputf(calldepth+1, level, format, v...)
 }
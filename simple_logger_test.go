@@ -0,0 +1,284 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConfigureWhileLogging(t *testing.T) {
+	l := New(LstdFlags)
+	l.SetOutput(io.Discard)
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					l.Info("message")
+					l.WithField("key", "value").Infof("formatted %d", 1)
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < 1000; i++ {
+		l.SetFlags(LstdFlags | Lshortfile)
+		l.SetShortLevels(i%2 == 0)
+		l.SetColors(i%2 == 0)
+		l.SetFormatter(&TextFormatter{})
+		l.SetDefaultFields(Fields{"i": i})
+		l.SetLevelVar(NewLevelVar(LevelInfo))
+		l.SetStrictFormat(i%2 == 0)
+	}
+	close(stop)
+	wg.Wait()
+}
+
+func TestDefaultSetLevelWhileLogging(t *testing.T) {
+	defer Reset()
+	SetOutput(io.Discard)
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					Info("message")
+					Debug("message")
+				}
+			}
+		}()
+	}
+
+	levels := Levels()
+	for i := 0; i < 1000; i++ {
+		SetLevel(levels[i%len(levels)])
+		SetFlags(LstdFlags | Lshortfile)
+	}
+	close(stop)
+	wg.Wait()
+}
+
+// writeRecorder records every Write call separately
+type writeRecorder struct {
+	mu     sync.Mutex
+	writes [][]byte
+}
+
+func (r *writeRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.writes = append(r.writes, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func TestWriteLinesNotSplit(t *testing.T) {
+	for _, bufferSize := range []int{0, 4096} {
+		t.Run("buffered="+strconv.Itoa(bufferSize), func(t *testing.T) {
+			recorder := &writeRecorder{}
+			l := New(0)
+			l.SetColors(false)
+			l.SetOutput(recorder)
+			l.SetBuffered(bufferSize)
+
+			const goroutines, entries = 8, 200
+			var wg sync.WaitGroup
+			for i := 0; i < goroutines; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					payload := strings.Repeat(strconv.Itoa(i), 1000)
+					for j := 0; j < entries; j++ {
+						l.Info(payload)
+					}
+				}(i)
+			}
+			wg.Wait()
+			if err := l.Flush(); err != nil {
+				t.Fatalf("Flush returned error: %s", err)
+			}
+
+			var lines int
+			for _, p := range recorder.writes {
+				if !bytes.HasSuffix(p, []byte("\n")) {
+					t.Fatalf("write doesn't end with a complete line: %q", p)
+				}
+				for _, line := range strings.Split(strings.TrimSuffix(string(p), "\n"), "\n") {
+					payload := strings.TrimPrefix(line, "INFO  ")
+					if len(payload) != 1000 || strings.Count(payload, payload[:1]) != 1000 {
+						t.Fatalf("line is split or interleaved: %q", line)
+					}
+					lines++
+				}
+			}
+			if lines != goroutines*entries {
+				t.Fatalf("expected %d lines, got %d", goroutines*entries, lines)
+			}
+		})
+	}
+}
+
+func TestWriterCountsSuppressed(t *testing.T) {
+	l := New(0)
+	l.SetOutput(io.Discard)
+	l.SetLevel(LevelWarn)
+
+	w := l.Writer(LevelInfo)
+	_, _ = w.Write([]byte("first\nsecond\n"))
+	if suppressed := l.Suppressed(); suppressed != 2 {
+		t.Errorf("expected 2 suppressed entries, got: %d", suppressed)
+	}
+}
+
+func TestSetOutputNil(t *testing.T) {
+	l := New(0)
+	l.SetOutput(nil)
+	l.Info("discarded")
+	l.WithField("key", "value").Error("discarded")
+	if err := l.Flush(); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
+
+func TestNonFormatMethodsKeepPercent(t *testing.T) {
+	defer Reset()
+	buf := &bytes.Buffer{}
+	SetOutput(buf)
+	SetFlags(Lnolevel)
+	SetColors(false)
+
+	Info("100% done")
+	if got := buf.String(); got != "100% done\n" {
+		t.Errorf("expected %q, got %q", "100% done\n", got)
+	}
+
+	buf.Reset()
+	Default().WithField("key", "value").Warn("100% done")
+	if got := buf.String(); got != "100% done key=value\n" {
+		t.Errorf("expected %q, got %q", "100% done key=value\n", got)
+	}
+}
+
+func TestInfoWithoutArgs(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := New(0)
+	l.SetColors(false)
+	l.SetOutput(buf)
+
+	var observed []Entry
+	l.OnEntry(func(entry Entry) {
+		observed = append(observed, entry)
+	})
+
+	l.Info()
+	if got := buf.String(); got != "INFO  \n" {
+		t.Errorf("expected an entry with an empty message, got %q", got)
+	}
+	if len(observed) != 1 || observed[0].Message != "" {
+		t.Errorf("expected observers to be called with an empty message, got %v", observed)
+	}
+}
+
+func TestSetDefaultConfiguresCustomLogger(t *testing.T) {
+	defer Reset()
+	custom := New(0)
+	SetDefault(custom)
+
+	buf := &bytes.Buffer{}
+	SetOutput(buf)
+	SetColors(false)
+	SetLevel(LevelWarn)
+	Info("hidden")
+	Warn("shown")
+	if got := buf.String(); got != "WARN  shown\n" {
+		t.Errorf("expected the package level configuration to apply to the custom default, got %q", got)
+	}
+	if custom.Enabled(LevelInfo) {
+		t.Error("expected the level of the custom default to be configured")
+	}
+}
+
+func TestOtherLoggersUsedThroughInterface(t *testing.T) {
+	defer Reset()
+	buf := &bytes.Buffer{}
+	SetOutput(buf)
+	SetColors(false)
+	SetFlags(0)
+
+	for _, logger := range []Logger{Default(), NewNoop()} {
+		logger.Info("message")
+	}
+	if got := buf.String(); got != "INFO  message\n" {
+		t.Errorf("expected only the default SimpleLogger to write, got %q", got)
+	}
+}
+
+func TestResetClosesDefault(t *testing.T) {
+	defer Reset()
+	buf := &lockedBuffer{}
+	SetOutput(buf)
+	SetColors(false)
+	SetFlags(0)
+	Default().SetAsync(8)
+
+	closed := false
+	l := Default()
+	l.mu.Lock()
+	l.closer = closerFunc(func() error {
+		closed = true
+		return nil
+	})
+	l.mu.Unlock()
+
+	Info("queued")
+	Reset()
+	if got := buf.String(); got != "INFO  queued\n" {
+		t.Errorf("expected the queued entry to be written, got %q", got)
+	}
+	if !closed {
+		t.Error("expected the previous default to be closed")
+	}
+	if Default() == l {
+		t.Error("expected a new default SimpleLogger")
+	}
+}
+
+func TestAtLevelOverridesComponentLevel(t *testing.T) {
+	SetComponentLevels(map[string]Level{"db": LevelError})
+	defer SetComponentLevels(nil)
+
+	buf := &bytes.Buffer{}
+	l := New(0)
+	l.SetOutput(buf)
+	named := l.WithName("db")
+
+	// Output instead of Debug which the release build compiles out
+	named.Output(2, LevelDebug, "hidden")
+	named.AtLevel(LevelDebug, func(scoped *SimpleLogger) {
+		if !scoped.Enabled(LevelDebug) {
+			t.Error("expected the scoped Level to take precedence over the component Level")
+		}
+		scoped.Output(2, LevelDebug, "scoped")
+		LogIfEnabled(scoped, LevelDebug, "fast")
+	})
+	got := buf.String()
+	if strings.Contains(got, "hidden") || !strings.Contains(got, "scoped") || !strings.Contains(got, "fast") {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
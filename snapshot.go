@@ -0,0 +1,102 @@
+package log
+
+import (
+	"io"
+	"sync/atomic"
+)
+
+// State is a snapshot of the configuration of a SimpleLogger created with Snapshot.
+// Unlike Config it holds the exact writers, Formatter(s) and Fields
+type State struct {
+	logger SimpleLogger
+	level  Level
+	w      io.Writer
+	levels map[Level]io.Writer
+}
+
+// Snapshot returns the current configuration of the SimpleLogger like the Level, flags, outputs, Formatter(s), Fields and colors.
+// Pass it to Restore to undo all changes made after the Snapshot
+func (l *SimpleLogger) Snapshot() State {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	levels := make(map[Level]io.Writer, len(l.levels))
+	for level, w := range l.levels {
+		levels[level] = w
+	}
+	return State{
+		logger: *l.clone(),
+		level:  l.levelVar().Load(),
+		w:      l.w,
+		levels: levels,
+	}
+}
+
+// Restore applies a State previously returned by Snapshot of this SimpleLogger.
+// The output is shared with all copies of the SimpleLogger so restoring it affects them as well
+func (l *SimpleLogger) Restore(state State) {
+	l.configMu.Lock()
+	l.restoreConfig(&state.logger)
+	// clone copies level under configMu so it must only be replaced while holding it
+	atomic.StorePointer(&l.level, state.logger.level)
+	l.configMu.Unlock()
+	l.levelVar().Store(state.level)
+	l.SetOutput(state.w)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// copy the writers so the State can be restored multiple times
+	l.levels = make(map[Level]io.Writer, len(state.levels))
+	for level, w := range state.levels {
+		l.levels[level] = w
+	}
+}
+
+// restoreConfig copies the configuration fields of from. The caller must hold configMu.
+// The output, level, name, scopedLevel and grouped are read without configMu and are left unchanged by it
+func (l *SimpleLogger) restoreConfig(from *SimpleLogger) {
+	l.flags = from.flags
+	l.levelFlags = from.levelFlags
+	l.flagsOn = from.flagsOn
+	l.flagsOff = from.flagsOff
+	l.bareLevels = from.bareLevels
+	l.colors = from.colors
+	l.autoColors = from.autoColors
+	l.strictFormat = from.strictFormat
+	l.messageFilters = from.messageFilters
+	l.maxMessageLen = from.maxMessageLen
+	l.maxFields = from.maxFields
+	l.fatalNoExit = from.fatalNoExit
+	l.development = from.development
+	l.exitOnError = from.exitOnError
+	l.exitLevel = from.exitLevel
+	l.humanReadable = from.humanReadable
+	l.selfDebug = from.selfDebug
+	l.alignFields = from.alignFields
+	l.formatter = from.formatter
+	l.levelFormatters = from.levelFormatters
+	l.formatFunc = from.formatFunc
+	l.callerTrim = from.callerTrim
+	l.location = from.location
+	l.clock = from.clock
+	l.epochUnit = from.epochUnit
+	l.valueFormatter = from.valueFormatter
+	l.levelFormat = from.levelFormat
+	l.bytesEncoding = from.bytesEncoding
+	l.defaultFields = from.defaultFields
+	l.fields = from.fields
+	l.fieldOrder = from.fieldOrder
+	l.fieldKeys = from.fieldKeys
+	l.groups = from.groups
+	l.err = from.err
+	l.stacktraceLevel = from.stacktraceLevel
+}
+
+// Snapshot returns the current configuration of the default SimpleLogger
+func Snapshot() State {
+	return Default().Snapshot()
+}
+
+// Restore applies a State previously returned by Snapshot to the default SimpleLogger
+func Restore(state State) {
+	Default().Restore(state)
+}
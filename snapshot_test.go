@@ -0,0 +1,53 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+func TestRestoreWhileLogging(t *testing.T) {
+	l := New(LstdFlags)
+	l.SetOutput(io.Discard)
+	state := l.Snapshot()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				l.Info("message")
+				l.WithField("key", "value").Debug("message")
+				runtime.Gosched()
+			}
+		}()
+	}
+	for i := 0; i < 200; i++ {
+		l.SetFlags(Lshortfile)
+		l.SetLevel(LevelDebug)
+		l.Restore(state)
+		runtime.Gosched()
+	}
+	wg.Wait()
+}
+
+func TestRestore(t *testing.T) {
+	buf := &bytes.Buffer{}
+	l := New(0)
+	l.SetColors(false)
+	l.SetOutput(buf)
+	state := l.Snapshot()
+
+	l.SetLevelVar(NewLevelVar(LevelError))
+	l.SetFlags(Lnolevel)
+	l.SetOutput(io.Discard)
+	l.Restore(state)
+
+	l.Info("message")
+	if got := buf.String(); got != "INFO  message\n" {
+		t.Errorf("expected the restored configuration, got %q", got)
+	}
+}
@@ -0,0 +1,92 @@
+package log
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// WithError returns a copy of the SimpleLogger which attaches the error as "error" field to each entry.
+// If a stacktrace Level is set the stack of the error is attached as well.
+// The copy shares its output with the SimpleLogger it was created from
+func (l *SimpleLogger) WithError(err error) *SimpleLogger {
+	clone := l.WithField("error", err)
+	clone.err = err
+	return clone
+}
+
+// SetStacktraceLevel sets the Level at and above which entries get a "stacktrace" field.
+// If the error attached with WithError has a StackTrace method like errors of github.com/pkg/errors,
+// the stack captured by the error is used. Otherwise the stack of the log call is captured
+func (l *SimpleLogger) SetStacktraceLevel(level Level) {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.stacktraceLevel = &level
+}
+
+// DisableStacktrace stops attaching the "stacktrace" field enabled by SetStacktraceLevel
+func (l *SimpleLogger) DisableStacktrace() {
+	l.configMu.Lock()
+	defer l.configMu.Unlock()
+	l.stacktraceLevel = nil
+}
+
+// stacktrace returns the stack of the error attached with WithError or the stack of the log call.
+// calldepth is the calldepth passed to log
+func (l *SimpleLogger) stacktrace(calldepth int) string {
+	if stack := errorStack(l.err); stack != "" {
+		return stack
+	}
+	return callerStack(calldepth + 2)
+}
+
+// errorStack returns the stack of the innermost error in the chain of err which has a StackTrace method.
+// The method is called via reflection so github.com/pkg/errors doesn't need to be imported
+func errorStack(err error) string {
+	var stack string
+	for ; err != nil; err = errors.Unwrap(err) {
+		method := reflect.ValueOf(err).MethodByName("StackTrace")
+		if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+			continue
+		}
+		stack = strings.TrimPrefix(fmt.Sprintf("%+v", method.Call(nil)[0].Interface()), "\n")
+	}
+	return stack
+}
+
+// callerStack returns the stack starting at the caller skipping calldepth frames like runtime.Caller
+func callerStack(calldepth int) string {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(calldepth+1, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	for {
+		frame, more := frames.Next()
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(frame.Function)
+		b.WriteString("\n\t")
+		b.WriteString(frame.File)
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(frame.Line))
+		if !more {
+			break
+		}
+	}
+	return b.String()
+}
+
+// WithError returns a copy of the default SimpleLogger which attaches the error as "error" field to each entry
+func WithError(err error) *SimpleLogger {
+	return Default().WithError(err)
+}
+
+// SetStacktraceLevel sets the Level at and above which entries of the default Logger get a "stacktrace" field
+func SetStacktraceLevel(level Level) {
+	Default().SetStacktraceLevel(level)
+}
@@ -0,0 +1,104 @@
+package log
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// WithStruct returns a copy of the SimpleLogger which attaches the exported fields of the given struct to each entry.
+// Field names can be changed with the `log:"name"` tag and fields tagged with `log:"-"` are skipped.
+// Nested structs are attached as a group with their exported fields and are not traversed any deeper.
+// Nested values which render themselves like time.Time or errors and structs without exported fields are attached as they are.
+// Passing a nil pointer or a non struct value returns the SimpleLogger unchanged
+func (l *SimpleLogger) WithStruct(v any) *SimpleLogger {
+	fields := structFields(reflect.ValueOf(v), true)
+	if fields == nil {
+		return l
+	}
+	return l.WithFields(fields)
+}
+
+// structFields returns the exported fields of the struct rv points to or nil if rv is not a struct.
+// Nested structs are only converted to Fields if nested is true
+func structFields(rv reflect.Value, nested bool) Fields {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	rt := rv.Type()
+	fields := make(Fields, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("log"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+
+		value := rv.Field(i)
+		if nested && isGroupable(value) {
+			if group := structFields(value, false); group != nil {
+				fields[name] = group
+				continue
+			}
+		}
+		fields[name] = value.Interface()
+	}
+	return fields
+}
+
+// selfRenderingTypes are the interfaces of values which are attached as they are instead of as a group
+var selfRenderingTypes = []reflect.Type{
+	reflect.TypeOf((*fmt.Stringer)(nil)).Elem(),
+	reflect.TypeOf((*error)(nil)).Elem(),
+	reflect.TypeOf((*json.Marshaler)(nil)).Elem(),
+	reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem(),
+}
+
+// isGroupable reports whether the nested value rv is a struct with exported fields which doesn't render itself
+func isGroupable(rv reflect.Value) bool {
+	for rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return false
+		}
+		rv = rv.Elem()
+	}
+	rt := rv.Type()
+	for _, t := range selfRenderingTypes {
+		if rt.Implements(t) || reflect.PointerTo(rt).Implements(t) {
+			return false
+		}
+	}
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+	if rt.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < rt.NumField(); i++ {
+		if rt.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+// WithStruct returns a copy of the default SimpleLogger which attaches the exported fields of the given struct to each entry
+func WithStruct(v any) *SimpleLogger {
+	return Default().WithStruct(v)
+}
@@ -0,0 +1,65 @@
+package log
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type structFieldsAddress struct {
+	City string `log:"city"`
+}
+
+type structFieldsRequest struct {
+	ID       int    `log:"id"`
+	Secret   string `log:"-"`
+	Created  time.Time
+	Err      error
+	Address  structFieldsAddress `log:"address"`
+	Empty    struct{ hidden int }
+	internal string
+}
+
+func TestStructFields(t *testing.T) {
+	created := time.Date(2009, 1, 23, 1, 23, 23, 0, time.UTC)
+	err := errors.New("failed")
+	fields := structFields(reflect.ValueOf(structFieldsRequest{
+		ID:      1,
+		Secret:  "secret",
+		Created: created,
+		Err:     err,
+		Address: structFieldsAddress{City: "Berlin"},
+	}), true)
+
+	if fields["id"] != 1 {
+		t.Errorf("expected id 1, got %v", fields["id"])
+	}
+	if _, ok := fields["Secret"]; ok {
+		t.Error("expected the field tagged with - to be skipped")
+	}
+	if _, ok := fields["internal"]; ok {
+		t.Error("expected the unexported field to be skipped")
+	}
+	if fields["Created"] != created {
+		t.Errorf("expected time.Time to be attached as it is, got %#v", fields["Created"])
+	}
+	if fields["Err"] != err {
+		t.Errorf("expected error to be attached as it is, got %#v", fields["Err"])
+	}
+	address, ok := fields["address"].(Fields)
+	if !ok || address["city"] != "Berlin" {
+		t.Errorf("expected nested struct to be attached as group, got %#v", fields["address"])
+	}
+	if _, ok = fields["Empty"].(Fields); ok {
+		t.Error("expected struct without exported fields to be attached as it is")
+	}
+}
+
+func TestWithStructNilPointer(t *testing.T) {
+	l := New(0)
+	var request *structFieldsRequest
+	if l.WithStruct(request) != l {
+		t.Error("expected a nil pointer to return the SimpleLogger unchanged")
+	}
+}
@@ -0,0 +1,139 @@
+package log
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+var _ Formatter = (*SyslogRFC5424Formatter)(nil)
+
+// rfc5424TimeFormat is the timestamp format of RFC 5424 which allows at most microsecond precision
+const rfc5424TimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// SyslogRFC5424Formatter renders entries in the RFC 5424 syslog wire format.
+// Fields are rendered as structured data element with group fields prefixed like group.key.
+// It only renders the message and can be used with any transport like UDP, TCP or a file
+type SyslogRFC5424Formatter struct {
+	// Facility is the syslog facility code used to calculate the priority. Defaults to 1 (user-level messages)
+	Facility int
+	// Hostname is the HOSTNAME field. Defaults to os.Hostname
+	Hostname string
+	// AppName is the APP-NAME field. Defaults to the base name of os.Args[0]
+	AppName string
+	// MsgID is the MSGID field. Defaults to "-"
+	MsgID string
+	// StructuredDataID is the SD-ID of the structured data element holding the fields. Defaults to "fields@32473"
+	StructuredDataID string
+}
+
+// Format renders the Entry as a single RFC 5424 syslog message
+func (f *SyslogRFC5424Formatter) Format(entry Entry) ([]byte, error) {
+	facility := f.Facility
+	if facility == 0 {
+		facility = 1
+	}
+
+	var b strings.Builder
+	b.WriteByte('<')
+	b.WriteString(strconv.Itoa(facility*8 + syslogSeverity(entry.Level)))
+	b.WriteString(">1 ")
+	b.WriteString(entry.Time.Format(rfc5424TimeFormat))
+	b.WriteByte(' ')
+	b.WriteString(syslogHeaderField(f.Hostname, hostname))
+	b.WriteByte(' ')
+	b.WriteString(syslogHeaderField(f.AppName, appName))
+	b.WriteByte(' ')
+	b.WriteString(strconv.Itoa(pid))
+	b.WriteByte(' ')
+	b.WriteString(syslogHeaderField(f.MsgID, nil))
+	b.WriteByte(' ')
+
+	if len(entry.Fields) == 0 {
+		b.WriteByte('-')
+	} else {
+		b.WriteByte('[')
+		b.WriteString(orDefault(f.StructuredDataID, "fields@32473"))
+		entry.walkFields(func(key string, value any) {
+			b.WriteByte(' ')
+			b.WriteString(syslogParamName(key))
+			b.WriteString(`="`)
+			b.WriteString(syslogParamValue(entry.formatValue(value)))
+			b.WriteByte('"')
+		})
+		b.WriteByte(']')
+	}
+
+	if entry.Message != "" {
+		b.WriteByte(' ')
+		b.WriteString(entry.Message)
+	}
+	b.WriteByte('\n')
+	return []byte(b.String()), nil
+}
+
+// syslogSeverity maps the Level to the syslog severity
+func syslogSeverity(level Level) int {
+	switch level {
+	case LevelTrace, LevelDebug:
+		return 7
+	case LevelInfo:
+		return 6
+	case LevelWarn:
+		return 4
+	case LevelError:
+		return 3
+	case LevelDPanic, LevelFatal:
+		return 2
+	case LevelPanic:
+		return 1
+	default:
+		return 5
+	}
+}
+
+func appName() string {
+	if len(os.Args) == 0 {
+		return ""
+	}
+	name := os.Args[0]
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// syslogHeaderField returns value, the result of def or the nil value "-" with all characters which are not allowed replaced
+func syslogHeaderField(value string, def func() string) string {
+	if value == "" && def != nil {
+		value = def()
+	}
+	if value == "" {
+		return "-"
+	}
+	return strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+}
+
+// syslogParamName replaces all characters which are not allowed in a PARAM-NAME and cuts it to 32 characters
+func syslogParamName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, name)
+	if len(name) > 32 {
+		name = name[:32]
+	}
+	return name
+}
+
+// syslogParamValue escapes '"', '\' and ']' in a PARAM-VALUE
+func syslogParamValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
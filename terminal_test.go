@@ -0,0 +1,22 @@
+package log
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestIsTerminal(t *testing.T) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devNull.Close()
+
+	if isTerminal(devNull) {
+		t.Error("expected /dev/null to be no terminal")
+	}
+	if isTerminal(&bytes.Buffer{}) {
+		t.Error("expected a bytes.Buffer to be no terminal")
+	}
+}
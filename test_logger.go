@@ -0,0 +1,95 @@
+package log
+
+import (
+	"io"
+	"strings"
+	"sync"
+)
+
+// NewTestLogger returns a TestLogger which records all entries on LevelTrace and above and discards its output
+func NewTestLogger() *TestLogger {
+	t := &TestLogger{
+		SimpleLogger: New(LstdFlags),
+	}
+	t.SetLevel(LevelTrace)
+	t.SetOutput(io.Discard)
+	t.SetFormatter(&recordingFormatter{
+		recorder:  t,
+		formatter: &TextFormatter{},
+	})
+	return t
+}
+
+// TestLogger is a SimpleLogger which records all entries so tests can assert on them.
+// Entries of copies created with WithField, WithFields and WithGroup are recorded as well.
+// Replacing the Formatter stops the recording
+type TestLogger struct {
+	*SimpleLogger
+
+	mu      sync.Mutex
+	entries []Entry
+}
+
+// Entries returns all recorded entries
+func (t *TestLogger) Entries() []Entry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Entry(nil), t.entries...)
+}
+
+// Reset clears all recorded entries and the Fields set with SetDefaultFields.
+// WithField, WithFields and WithGroup return copies so the TestLogger itself has no fields of them to clear.
+// Copies created before Reset keep their fields and are still recorded
+func (t *TestLogger) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = nil
+	t.configMu.Lock()
+	defer t.configMu.Unlock()
+	t.defaultFields = nil
+	t.fields = nil
+	t.fieldKeys = nil
+	t.err = nil
+	t.groups = nil
+}
+
+// ContainsMessage reports whether any recorded entry contains substr in its message
+func (t *TestLogger) ContainsMessage(substr string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, entry := range t.entries {
+		if strings.Contains(entry.Message, substr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountAtLevel returns the number of recorded entries on the given Level
+func (t *TestLogger) CountAtLevel(level Level) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var count int
+	for _, entry := range t.entries {
+		if entry.Level == level {
+			count++
+		}
+	}
+	return count
+}
+
+func (t *TestLogger) record(entry Entry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.entries = append(t.entries, entry)
+}
+
+type recordingFormatter struct {
+	recorder  *TestLogger
+	formatter Formatter
+}
+
+func (f *recordingFormatter) Format(entry Entry) ([]byte, error) {
+	f.recorder.record(entry)
+	return f.formatter.Format(entry)
+}
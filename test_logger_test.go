@@ -0,0 +1,29 @@
+package log
+
+import (
+	"testing"
+)
+
+func TestTestLoggerReset(t *testing.T) {
+	l := NewTestLogger()
+	l.SetDefaultFields(Fields{"default": 1})
+	derived := l.WithField("derived", 2)
+	derived.Info("before")
+
+	l.Reset()
+	if entries := l.Entries(); len(entries) != 0 {
+		t.Fatalf("expected no entries after Reset, got %d", len(entries))
+	}
+
+	derived.Info("after")
+	entries := l.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("expected the copy to still be recorded, got %d entries", len(entries))
+	}
+	if _, ok := entries[0].Fields["derived"]; !ok {
+		t.Errorf("expected the copy to keep its fields, got %v", entries[0].Fields)
+	}
+	if _, ok := entries[0].Fields["default"]; !ok {
+		t.Errorf("expected the copy to keep its default fields as they were copied, got %v", entries[0].Fields)
+	}
+}
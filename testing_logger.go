@@ -0,0 +1,32 @@
+package log
+
+import (
+	"bytes"
+)
+
+// TB is the subset of testing.TB used by NewTestingLogger
+type TB interface {
+	Helper()
+	Log(args ...any)
+}
+
+// NewTestingLogger returns a SimpleLogger which forwards each line of its output to t.Log.
+// This way the output is grouped per test and only shown by go test on failure or with -v
+func NewTestingLogger(t TB) *SimpleLogger {
+	l := New(LstdFlags)
+	l.SetColors(false)
+	l.SetOutput(&testingWriter{t: t})
+	return l
+}
+
+type testingWriter struct {
+	t TB
+}
+
+func (w *testingWriter) Write(p []byte) (int, error) {
+	w.t.Helper()
+	for _, line := range bytes.Split(bytes.TrimSuffix(p, []byte("\n")), []byte("\n")) {
+		w.t.Log(string(line))
+	}
+	return len(p), nil
+}
@@ -0,0 +1,45 @@
+package log
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+)
+
+// WatchLevelEnv re-reads the environment variable key each time sig is received and applies the parsed Level.
+// Values which can't be parsed are reported on LevelWarn and ignored.
+// The returned func stops watching
+func (l *SimpleLogger) WatchLevelEnv(key string, sig os.Signal) func() {
+	signals := make(chan os.Signal, 1)
+	done := make(chan struct{})
+	signal.Notify(signals, sig)
+
+	go func() {
+		for {
+			select {
+			case <-signals:
+				level, err := ParseLevel(os.Getenv(key))
+				if err != nil {
+					l.Warnf("failed to parse level from env %s: %s", key, err)
+					continue
+				}
+				l.SetLevel(level)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(signals)
+			close(done)
+		})
+	}
+}
+
+// WatchLevelEnv re-reads the environment variable key each time sig is received and applies the parsed Level to the default Logger
+func WatchLevelEnv(key string, sig os.Signal) func() {
+	return Default().WatchLevelEnv(key, sig)
+}
@@ -0,0 +1,22 @@
+package log
+
+import (
+	"time"
+)
+
+// WatchDuration starts timing the operation with the given name and returns a func which stops it.
+// If more than threshold elapsed until the returned func is called an entry on LevelWarn with the name and duration is logged.
+// Use it like defer l.WatchDuration("db.query", 100*time.Millisecond)()
+func (l *SimpleLogger) WatchDuration(name string, threshold time.Duration) func() {
+	start := l.now()
+	return func() {
+		if elapsed := l.now().Sub(start); elapsed > threshold {
+			l.OutputFields(3, LevelWarn, Fields{"name": name, "duration": elapsed, "threshold": threshold}, "slow operation: ", name)
+		}
+	}
+}
+
+// WatchDuration starts timing the operation with the given name and logs on LevelWarn with the default Logger if it takes longer than threshold
+func WatchDuration(name string, threshold time.Duration) func() {
+	return Default().WatchDuration(name, threshold)
+}
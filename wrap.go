@@ -0,0 +1,19 @@
+package log
+
+import (
+	"log"
+)
+
+// Wrap returns a new SimpleLogger which adopts the output, flags and prefix of the given std *log.Logger.
+// Entries are rendered without colors like the std Logger renders them with the Level in front of the message.
+// Later changes to the std Logger are not picked up.
+// The SimpleLogger writes to the output of the std Logger directly instead of through it, so it doesn't share the lock of the std Logger.
+// If the std Logger is still used as well and its output is not safe for concurrent use, let the std Logger write through
+// a writer which holds a sync.Locker around each Write and pass the underlying output to SetOutput and the same sync.Locker to SetOutputLocker
+func Wrap(logger *log.Logger) *SimpleLogger {
+	l := New(logger.Flags())
+	l.SetOutput(logger.Writer())
+	l.SetFormatter(&TextFormatter{Prefix: logger.Prefix()})
+	l.SetColors(false)
+	return l
+}
@@ -0,0 +1,54 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// lockerWriter holds locker around each Write to w
+type lockerWriter struct {
+	locker sync.Locker
+	w      io.Writer
+}
+
+func (w *lockerWriter) Write(p []byte) (int, error) {
+	w.locker.Lock()
+	defer w.locker.Unlock()
+	return w.w.Write(p)
+}
+
+func TestWrapSharedOutputLocker(t *testing.T) {
+	buf := &bytes.Buffer{}
+	locker := &sync.Mutex{}
+	std := log.New(&lockerWriter{locker: locker, w: buf}, "", 0)
+
+	l := Wrap(std)
+	l.SetOutput(buf)
+	l.SetOutputLocker(locker)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			std.Print("std")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			l.Info("wrapped")
+		}
+	}()
+	wg.Wait()
+
+	locker.Lock()
+	defer locker.Unlock()
+	if got := strings.Count(buf.String(), "\n"); got != 200 {
+		t.Errorf("expected 200 lines, got %d", got)
+	}
+}
@@ -0,0 +1,123 @@
+package log
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// ErrWriteTimeout is passed to the handler registered with OnWriteError if an entry wasn't written within the write timeout
+var ErrWriteTimeout = errors.New("log: write timed out")
+
+// SetWriteTimeout bounds how long writing an entry to the output may take so a hung output like a stalled network connection
+// can't block all logging goroutines. Entries which aren't written within d are dropped, counted by Dropped and reported
+// to the handler registered with OnWriteError or os.Stderr if there is none.
+// The tradeoff is that entries are lost while the output is slow. A write which timed out keeps running in the background
+// so its entry may still appear later and following writes, flushes and syncs wait for it up to the timeout.
+// A timeout <= 0 disables it which is the default
+func (l *SimpleLogger) SetWriteTimeout(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.writeTimeout = d
+	if l.writing == nil {
+		l.writing = make(chan struct{}, 1)
+	}
+}
+
+// OnWriteError registers a handler which is called with errors returned by the outputs, with an error wrapping
+// ErrWriteTimeout for each entry dropped by the write timeout and with errors of Formatter(s) and panics of observers,
+// message filters and lazy fields. Without handler these errors are written to os.Stderr except errors of the outputs.
+// The handler may be called while the output is locked so it must not log with the SimpleLogger or any of its copies. Pass nil to remove it
+func (l *SimpleLogger) OnWriteError(handler func(err error)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.onWriteError = handler
+}
+
+// OnWriteError registers a handler which is called with write errors of the default Logger, see SimpleLogger.OnWriteError
+func OnWriteError(handler func(err error)) {
+	Default().OnWriteError(handler)
+}
+
+// writeWithTimeout writes the pendingWrite in a separate goroutine and gives up after timeout.
+// Only one write runs at a time so the output is never written concurrently even if a previous write still hangs
+func (o *output) writeWithTimeout(pw pendingWrite, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case o.writing <- struct{}{}:
+	case <-timer.C:
+		o.writeTimedOut(timeout, pw.onError)
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		defer func() {
+			<-o.writing
+		}()
+		defer close(done)
+		writeLocked(pw)
+	}()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		o.writeTimedOut(timeout, pw.onError)
+	}
+}
+
+// acquireWriting waits up to timeout until no timed out write is running anymore so flushes and syncs never run
+// concurrently with a hung write. The returned func releases the output again. Without write timeout it does nothing
+func acquireWriting(writing chan struct{}, timeout time.Duration) (func(), error) {
+	if writing == nil || timeout <= 0 {
+		return func() {}, nil
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case writing <- struct{}{}:
+		return func() { <-writing }, nil
+	case <-timer.C:
+		return nil, fmt.Errorf("%w after %s", ErrWriteTimeout, timeout)
+	}
+}
+
+// writeTimedOut counts and reports an entry which wasn't written within timeout
+func (o *output) writeTimedOut(timeout time.Duration, onError func(err error)) {
+	atomic.AddUint64(&o.dropped, 1)
+	err := fmt.Errorf("%w after %s, entry dropped", ErrWriteTimeout, timeout)
+	if onError == nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", err)
+		return
+	}
+	notifyWriteError(onError, err)
+}
+
+// reportError passes err to the handler registered with OnWriteError or writes it to os.Stderr if there is none
+func (o *output) reportError(err error) {
+	o.mu.Lock()
+	onError := o.onWriteError
+	o.mu.Unlock()
+	if onError == nil {
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n", err)
+		return
+	}
+	notifyWriteError(onError, err)
+}
+
+// notifyWriteError calls onError with err if it is not nil and recovers from a panic of it
+func notifyWriteError(onError func(err error), err error) {
+	if onError == nil || err == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "log: write error handler panicked: %v\n", r)
+		}
+	}()
+	onError(err)
+}
@@ -0,0 +1,105 @@
+package log
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockingWriter blocks each Write until release is closed
+type blockingWriter struct {
+	release chan struct{}
+	lockedBuffer
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return w.lockedBuffer.Write(p)
+}
+
+func TestWriteTimeoutReportsError(t *testing.T) {
+	w := &blockingWriter{release: make(chan struct{})}
+	l := New(0)
+	l.SetOutput(w)
+	l.SetWriteTimeout(10 * time.Millisecond)
+
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+	l.OnWriteError(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	})
+
+	l.Info("hangs")
+	l.Info("dropped")
+
+	mu.Lock()
+	got := len(errs)
+	for _, err := range errs {
+		if !errors.Is(err, ErrWriteTimeout) {
+			t.Errorf("expected ErrWriteTimeout, got: %v", err)
+		}
+	}
+	mu.Unlock()
+	if got != 2 {
+		t.Errorf("expected 2 write errors, got: %d", got)
+	}
+	if dropped := l.Dropped(); dropped != 2 {
+		t.Errorf("expected 2 dropped entries, got: %d", dropped)
+	}
+	close(w.release)
+}
+
+func TestWriteTimeoutReportsOutputErrors(t *testing.T) {
+	writeErr := errors.New("disk full")
+	l := New(0)
+	l.SetOutput(writerFunc(func(p []byte) (int, error) {
+		return 0, writeErr
+	}))
+
+	var got error
+	l.OnWriteError(func(err error) {
+		got = err
+	})
+	l.Info("message")
+	if !errors.Is(got, writeErr) {
+		t.Errorf("expected %v, got: %v", writeErr, got)
+	}
+}
+
+func TestWriteTimeoutFlushWaitsForHungWrite(t *testing.T) {
+	w := &blockingWriter{release: make(chan struct{})}
+	l := New(0)
+	l.SetColors(false)
+	l.SetOutput(w)
+	l.SetBuffered(16)
+	l.SetWriteTimeout(10 * time.Millisecond)
+	l.OnWriteError(func(error) {})
+
+	// the entry is larger than the buffer so the write hangs in the output
+	l.Info("entry which is larger than the buffer")
+	if err := l.Flush(); !errors.Is(err, ErrWriteTimeout) {
+		t.Errorf("expected Flush to time out while the write hangs, got: %v", err)
+	}
+
+	close(w.release)
+	time.Sleep(10 * time.Millisecond)
+	l.Info("next")
+	if err := l.Flush(); err != nil {
+		t.Errorf("expected Flush to succeed, got: %v", err)
+	}
+	if got := w.String(); got != "INFO  entry which is larger than the buffer\nINFO  next\n" {
+		t.Errorf("unexpected output: %q", got)
+	}
+}
+
+// writerFunc is an io.Writer calling the func
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
@@ -0,0 +1,66 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// Writer returns an io.WriteCloser which logs everything written to it on the given Level.
+// This can be used to bridge libraries which only accept an io.Writer or *log.Logger from the std library.
+// Each line is logged as separate entry. Partial lines are buffered until a newline is written or Close is called.
+// Writes below the Level of the SimpleLogger are dropped but still report the full length as written. Their lines are counted by Suppressed
+func (l *SimpleLogger) Writer(level Level) io.WriteCloser {
+	return &levelWriter{logger: l, level: level}
+}
+
+type levelWriter struct {
+	logger *SimpleLogger
+	level  Level
+
+	mu      sync.Mutex
+	partial []byte
+}
+
+func (w *levelWriter) Write(p []byte) (int, error) {
+	if !w.logger.Enabled(w.level) {
+		atomic.AddUint64(&w.logger.suppressed, uint64(bytes.Count(p, []byte("\n"))))
+		return len(p), nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data := p
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		line := data[:i]
+		if len(w.partial) > 0 {
+			line = append(w.partial, line...)
+			w.partial = w.partial[:0]
+		}
+		w.logger.Output(3, w.level, string(bytes.TrimSuffix(line, []byte("\r"))))
+		data = data[i+1:]
+	}
+	w.partial = append(w.partial, data...)
+	return len(p), nil
+}
+
+// Close logs the buffered partial line if there is one
+func (w *levelWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.partial) > 0 {
+		w.logger.Output(3, w.level, string(w.partial))
+		w.partial = nil
+	}
+	return nil
+}
+
+// Writer returns an io.WriteCloser which logs everything written to it on the given Level with the default SimpleLogger
+func Writer(level Level) io.WriteCloser {
+	return Default().Writer(level)
+}